@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Build numbers`, func() {
+	var testServer *httptest.Server
+	var nextBuildNumber int64
+	var pages int
+	BeforeEach(func() {
+		nextBuildNumber, pages = 10, 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1":
+				fmt.Fprintf(res, `{"id": "pipeline-1", "build_number": 5, "next_build_number": %d}`, nextBuildNumber)
+			case "PATCH /tekton_pipelines/pipeline-1":
+				var patch map[string]int64
+				Expect(json.NewDecoder(req.Body).Decode(&patch)).To(Succeed())
+				nextBuildNumber = patch["next_build_number"]
+				fmt.Fprintf(res, `{"id": "pipeline-1", "build_number": 5, "next_build_number": %d}`, nextBuildNumber)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs":
+				pages++
+				if req.URL.Query().Get("start") == "" {
+					fmt.Fprintf(res, "%s", `{"limit": 2, "first": {"href": "https://cloud.ibm.com"}, "next": {"href": "https://cloud.ibm.com?start=page-2"},
+						"pipeline_runs": [{"id": "run-5", "event_params_blob": "{\"build_number\": 5}"},
+							{"id": "run-4", "event_params_blob": "{}", "description": "Release build #4"}]}`)
+				} else {
+					fmt.Fprintf(res, "%s", `{"limit": 2, "first": {"href": "https://cloud.ibm.com"},
+						"pipeline_runs": [{"id": "run-2", "event_params_blob": "{\"BUILD_NUMBER\": \"3\"}"}, {"id": "run-1", "description": "manual run"}]}`)
+				}
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	Describe(`FindTektonPipelineRunByBuildNumber(ctx, pipelineID, buildNumber)`, func() {
+		It(`Matches the build number in the event parameters, stopping at the page that holds the run`, func() {
+			run, err := newService().FindTektonPipelineRunByBuildNumber(context.Background(), "pipeline-1", 3)
+			Expect(err).To(BeNil())
+			Expect(*run.ID).To(Equal("run-2"))
+			Expect(pages).To(Equal(2))
+		})
+		It(`Returns an error when the run cannot be identified`, func() {
+			run, err := newService().FindTektonPipelineRunByBuildNumber(context.Background(), "pipeline-1", 2)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the build number of 2 runs could not be identified"))
+			Expect(run).To(BeNil())
+		})
+		It(`Does not guess the build number from the description`, func() {
+			run, err := newService().FindTektonPipelineRunByBuildNumber(context.Background(), "pipeline-1", 4)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("could not be identified"))
+			Expect(run).To(BeNil())
+		})
+		It(`Returns nil for unknown build numbers`, func() {
+			run, err := newService().FindTektonPipelineRunByBuildNumber(context.Background(), "pipeline-1", 6)
+			Expect(err).To(BeNil())
+			Expect(run).To(BeNil())
+			Expect(pages).To(Equal(0))
+		})
+	})
+	Describe(`SetNextBuildNumber(ctx, pipelineID, nextBuildNumber)`, func() {
+		It(`Moves the next build number forward`, func() {
+			pipeline, err := newService().SetNextBuildNumber(context.Background(), "pipeline-1", 20)
+			Expect(err).To(BeNil())
+			Expect(*pipeline.NextBuildNumber).To(Equal(int64(20)))
+		})
+		It(`Refuses to move the next build number backwards`, func() {
+			_, err := newService().SetNextBuildNumber(context.Background(), "pipeline-1", 9)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("cannot be lowered to 9"))
+			Expect(nextBuildNumber).To(Equal(int64(10)))
+		})
+	})
+	Describe(`ReserveBuildNumberRange(ctx, pipelineID, count)`, func() {
+		It(`Reserves consecutive build numbers`, func() {
+			first, err := newService().ReserveBuildNumberRange(context.Background(), "pipeline-1", 3)
+			Expect(err).To(BeNil())
+			Expect(first).To(Equal(int64(10)))
+			Expect(nextBuildNumber).To(Equal(int64(13)))
+			_, err = newService().ReserveBuildNumberRange(context.Background(), "pipeline-1", 0)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
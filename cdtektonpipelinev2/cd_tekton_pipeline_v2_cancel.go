@@ -0,0 +1,255 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CancelRunsSelector : Selects the runs cancelled by CancelTektonPipelineRuns.
+type CancelRunsSelector struct {
+	// Only cancel runs started by one of these triggers. All triggers match when empty.
+	TriggerNames []string
+
+	// Only cancel runs on one of these workers, given by worker ID or name. Runs and triggers without a worker run on
+	// the default worker of the pipeline. All workers match when empty.
+	Workers []string
+
+	// Force the cancellation of the runs.
+	Force bool
+
+	// Disable the matching enabled triggers before cancelling, so that no new runs are started.
+	DisableTriggers bool
+}
+
+// CancelRunResult : The outcome of cancelling a single pipeline run.
+type CancelRunResult struct {
+	// The ID of the run.
+	RunID string `json:"run_id"`
+
+	// The name of the trigger that started the run.
+	TriggerName string `json:"trigger_name"`
+
+	// The status of the run before it was cancelled.
+	PreviousStatus string `json:"previous_status"`
+
+	// The status of the run returned by CancelTektonPipelineRun.
+	Status string `json:"status,omitempty"`
+
+	// The error returned by CancelTektonPipelineRun, if any.
+	Error error `json:"-"`
+}
+
+// CancelRunsReport : The outcome of CancelTektonPipelineRuns.
+type CancelRunsReport struct {
+	// The IDs of the triggers that were disabled.
+	DisabledTriggers []string `json:"disabled_triggers"`
+
+	// The result for each run that was selected.
+	Results []CancelRunResult `json:"results"`
+}
+
+// activePipelineRunStatuses lists the statuses of runs that can be cancelled.
+var activePipelineRunStatuses = []string{
+	PipelineRunStatusPendingConst,
+	PipelineRunStatusQueuedConst,
+	PipelineRunStatusWaitingConst,
+	PipelineRunStatusRunningConst,
+}
+
+// CancelTektonPipelineRuns cancels every pending, queued, waiting and running run of a pipeline that matches the
+// selector. When the selector asks for it, the matching triggers are disabled first. A failure to cancel one run does
+// not stop the others; the report holds the result for each run and an error is returned if any of them failed.
+func (cdTektonPipeline *CdTektonPipelineV2) CancelTektonPipelineRuns(ctx context.Context, pipelineID string, selector *CancelRunsSelector) (report *CancelRunsReport, err error) {
+	if selector == nil {
+		selector = &CancelRunsSelector{}
+	}
+	report = &CancelRunsReport{}
+
+	var defaultWorker *Worker
+	if len(selector.Workers) > 0 {
+		defaultWorker, err = cdTektonPipeline.pipelineWorker(ctx, pipelineID)
+		if err != nil {
+			return
+		}
+	}
+
+	if selector.DisableTriggers {
+		report.DisabledTriggers, err = cdTektonPipeline.disableTriggers(ctx, pipelineID, selector, defaultWorker)
+		if err != nil {
+			return
+		}
+	}
+
+	// A run can move to another status between two listings, so it is kept only the first time it is listed.
+	var runs []PipelineRun
+	seen := map[string]bool{}
+	for _, status := range activePipelineRunStatuses {
+		listOptions := cdTektonPipeline.NewListTektonPipelineRunsOptions(pipelineID).SetStatus(status)
+		if len(selector.TriggerNames) == 1 {
+			listOptions.SetTriggerName(selector.TriggerNames[0])
+		}
+		var pager *TektonPipelineRunsPager
+		pager, err = cdTektonPipeline.NewTektonPipelineRunsPager(listOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "cancel-pager-error")
+			return
+		}
+		var page []PipelineRun
+		page, err = pager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "cancel-list-error")
+			return
+		}
+		for _, run := range page {
+			if runID := core.StringNilMapper(run.ID); !seen[runID] {
+				seen[runID] = true
+				runs = append(runs, run)
+			}
+		}
+	}
+
+	failed := 0
+	for _, run := range runs {
+		result := CancelRunResult{
+			RunID:          core.StringNilMapper(run.ID),
+			PreviousStatus: core.StringNilMapper(run.Status),
+		}
+		if trigger := AsTrigger(run.Trigger); trigger != nil {
+			result.TriggerName = core.StringNilMapper(trigger.Name)
+		}
+		if result.RunID == "" || !selector.matchesTrigger(result.TriggerName) {
+			continue
+		}
+		if !selector.matchesRunWorker(run.Worker, defaultWorker) {
+			continue
+		}
+		cancelOptions := cdTektonPipeline.NewCancelTektonPipelineRunOptions(pipelineID, result.RunID)
+		if selector.Force {
+			cancelOptions.SetForce(true)
+		}
+		cancelled, _, cancelErr := cdTektonPipeline.CancelTektonPipelineRunWithContext(ctx, cancelOptions)
+		if cancelErr != nil {
+			result.Error = cancelErr
+			failed++
+		} else if cancelled != nil {
+			result.Status = core.StringNilMapper(cancelled.Status)
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	if failed > 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%d of %d pipeline runs could not be cancelled", failed, len(report.Results)), "cancel-runs-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// pipelineWorker returns the default worker of a pipeline, used by its runs and triggers that do not set a worker.
+func (cdTektonPipeline *CdTektonPipelineV2) pipelineWorker(ctx context.Context, pipelineID string) (worker *Worker, err error) {
+	pipeline, _, err := cdTektonPipeline.GetTektonPipelineWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineOptions(pipelineID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-pipeline-error")
+		return
+	}
+	worker = pipeline.Worker
+	if worker == nil {
+		worker = &Worker{}
+	}
+	return
+}
+
+// disableTriggers disables the enabled triggers of a pipeline that match the selector and returns their IDs. Triggers
+// without a worker are matched against the default worker of the pipeline.
+func (cdTektonPipeline *CdTektonPipelineV2) disableTriggers(ctx context.Context, pipelineID string, selector *CancelRunsSelector, defaultWorker *Worker) (disabled []string, err error) {
+	triggers, _, err := cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, cdTektonPipeline.NewListTektonPipelineTriggersOptions(pipelineID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-triggers-error")
+		return
+	}
+	patch, err := (&TriggerPatch{Enabled: core.BoolPtr(false)}).AsPatch()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "trigger-patch-error", common.GetComponentInfo())
+		return
+	}
+	for _, triggerIntf := range triggers.Triggers {
+		trigger := AsTrigger(triggerIntf)
+		if trigger == nil || trigger.ID == nil || (trigger.Enabled != nil && !*trigger.Enabled) {
+			continue
+		}
+		if !selector.matchesTrigger(core.StringNilMapper(trigger.Name)) {
+			continue
+		}
+		worker := trigger.Worker
+		if worker == nil {
+			worker = defaultWorker
+		}
+		if len(selector.Workers) > 0 && !selector.matchesWorker(worker.ID, worker.Name) {
+			continue
+		}
+		updateOptions := cdTektonPipeline.NewUpdateTektonPipelineTriggerOptions(pipelineID, *trigger.ID)
+		updateOptions.SetTriggerPatch(patch)
+		_, _, err = cdTektonPipeline.UpdateTektonPipelineTriggerWithContext(ctx, updateOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "disable-trigger-error")
+			return
+		}
+		disabled = append(disabled, *trigger.ID)
+	}
+	return
+}
+
+// matchesTrigger returns true if the selector selects the trigger with the specified name.
+func (selector *CancelRunsSelector) matchesTrigger(name string) bool {
+	if len(selector.TriggerNames) == 0 {
+		return true
+	}
+	for _, triggerName := range selector.TriggerNames {
+		if triggerName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWorker returns true if the selector selects the worker with the specified ID and name.
+func (selector *CancelRunsSelector) matchesWorker(id *string, name *string) bool {
+	if len(selector.Workers) == 0 {
+		return true
+	}
+	for _, worker := range selector.Workers {
+		if (id != nil && *id == worker) || (name != nil && *name == worker) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesRunWorker returns true if the selector selects the worker of a run, or the default worker of the pipeline when
+// the run has none.
+func (selector *CancelRunsSelector) matchesRunWorker(worker *PipelineRunWorker, defaultWorker *Worker) bool {
+	if len(selector.Workers) == 0 {
+		return true
+	}
+	if worker == nil {
+		return selector.matchesWorker(defaultWorker.ID, defaultWorker.Name)
+	}
+	return selector.matchesWorker(worker.ID, worker.Name)
+}
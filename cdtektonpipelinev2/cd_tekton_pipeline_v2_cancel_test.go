@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CancelTektonPipelineRuns(ctx, pipelineID, selector)`, func() {
+	var testServer *httptest.Server
+	var requests []string
+	var runStarted bool
+	BeforeEach(func() {
+		requests, runStarted = nil, false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			request := req.Method + " " + req.URL.EscapedPath()
+			if req.Method != "GET" {
+				body, _ := io.ReadAll(req.Body)
+				requests = append(requests, request+" "+strings.TrimSpace(string(body)))
+			}
+			switch request {
+			case "GET /tekton_pipelines/pipeline-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "pipeline-1", "worker": {"id": "public", "name": "IBM Managed workers"}}`)
+			case "GET /tekton_pipelines/pipeline-1/triggers":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"triggers": [
+					{"type": "manual", "id": "trigger-1", "name": "ci", "enabled": true},
+					{"type": "manual", "id": "trigger-2", "name": "deploy", "enabled": true},
+					{"type": "manual", "id": "trigger-3", "name": "nightly", "enabled": false}
+				]}`)
+			case "PATCH /tekton_pipelines/pipeline-1/triggers/trigger-1", "PATCH /tekton_pipelines/pipeline-1/triggers/trigger-2":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"type": "manual", "enabled": false}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs":
+				res.WriteHeader(200)
+				switch req.URL.Query().Get("status") {
+				case "pending":
+					fmt.Fprintf(res, "%s", `{"limit": 50, "first": {"href": "https://cloud.ibm.com"}, "pipeline_runs": [
+						{"id": "run-4", "status": "pending", "trigger": {"type": "manual", "name": "deploy"}}
+					]}`)
+				case "queued":
+					fmt.Fprintf(res, "%s", `{"limit": 50, "first": {"href": "https://cloud.ibm.com"}, "pipeline_runs": [
+						{"id": "run-1", "status": "queued", "trigger": {"type": "manual", "name": "ci"}, "worker": {"id": "public", "name": "IBM Managed workers"}},
+						{"id": "run-2", "status": "queued", "trigger": {"type": "manual", "name": "deploy"}, "worker": {"id": "worker-2", "name": "private"}}
+					]}`)
+				case "running":
+					started := ""
+					if runStarted {
+						started = `{"id": "run-1", "status": "running", "trigger": {"type": "manual", "name": "ci"}, "worker": {"id": "public", "name": "IBM Managed workers"}},`
+					}
+					fmt.Fprintf(res, `{"limit": 50, "first": {"href": "https://cloud.ibm.com"}, "pipeline_runs": [%s
+						{"id": "run-3", "status": "running", "trigger": {"type": "manual", "name": "ci"}, "worker": {"id": "worker-2", "name": "private"}}
+					]}`, started)
+				default:
+					fmt.Fprintf(res, "%s", `{"limit": 50, "first": {"href": "https://cloud.ibm.com"}, "pipeline_runs": []}`)
+				}
+			case "POST /tekton_pipelines/pipeline-1/pipeline_runs/run-1/cancel", "POST /tekton_pipelines/pipeline-1/pipeline_runs/run-2/cancel",
+				"POST /tekton_pipelines/pipeline-1/pipeline_runs/run-4/cancel":
+				res.WriteHeader(202)
+				fmt.Fprintf(res, "%s", `{"id": "run", "status": "cancelled"}`)
+			case "POST /tekton_pipelines/pipeline-1/pipeline_runs/run-3/cancel":
+				res.WriteHeader(500)
+				fmt.Fprintf(res, "%s", `{"errors": [{"message": "internal error"}]}`)
+			default:
+				Fail("unexpected request: " + request)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	It(`Cancels the active runs of the selected worker with force`, func() {
+		report, err := newService().CancelTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.CancelRunsSelector{
+			Workers: []string{"IBM Managed workers"},
+			Force:   true,
+		})
+		Expect(err).To(BeNil())
+		Expect(report.DisabledTriggers).To(BeEmpty())
+		Expect(report.Results).To(HaveLen(2))
+		Expect(report.Results[0].RunID).To(Equal("run-4"))
+		Expect(report.Results[1].RunID).To(Equal("run-1"))
+		Expect(report.Results[1].TriggerName).To(Equal("ci"))
+		Expect(report.Results[1].PreviousStatus).To(Equal("queued"))
+		Expect(report.Results[1].Status).To(Equal("cancelled"))
+		Expect(requests).To(Equal([]string{
+			`POST /tekton_pipelines/pipeline-1/pipeline_runs/run-4/cancel {"force":true}`,
+			`POST /tekton_pipelines/pipeline-1/pipeline_runs/run-1/cancel {"force":true}`,
+		}))
+	})
+	It(`Cancels a run listed under two statuses once`, func() {
+		runStarted = true
+		report, err := newService().CancelTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.CancelRunsSelector{
+			Workers: []string{"public"},
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Results).To(HaveLen(2))
+		Expect(report.Results[1].RunID).To(Equal("run-1"))
+		Expect(report.Results[1].PreviousStatus).To(Equal("queued"))
+		Expect(requests).To(Equal([]string{
+			`POST /tekton_pipelines/pipeline-1/pipeline_runs/run-4/cancel {}`,
+			`POST /tekton_pipelines/pipeline-1/pipeline_runs/run-1/cancel {}`,
+		}))
+	})
+	It(`Resolves runs and triggers without a worker to the pipeline's worker`, func() {
+		report, err := newService().CancelTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.CancelRunsSelector{
+			Workers:         []string{"worker-2"},
+			DisableTriggers: true,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(report.DisabledTriggers).To(BeEmpty())
+		Expect(report.Results).To(HaveLen(2))
+		Expect(report.Results[0].RunID).To(Equal("run-2"))
+		Expect(report.Results[1].RunID).To(Equal("run-3"))
+	})
+	It(`Disables the matching triggers first and reports failed cancellations`, func() {
+		report, err := newService().CancelTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.CancelRunsSelector{
+			TriggerNames:    []string{"ci", "nightly"},
+			DisableTriggers: true,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("1 of 2 pipeline runs could not be cancelled"))
+		Expect(report.DisabledTriggers).To(Equal([]string{"trigger-1"}))
+		Expect(report.Results).To(HaveLen(2))
+		Expect(report.Results[0].Error).To(BeNil())
+		Expect(report.Results[1].RunID).To(Equal("run-3"))
+		Expect(report.Results[1].Error).ToNot(BeNil())
+		Expect(requests[0]).To(Equal(`PATCH /tekton_pipelines/pipeline-1/triggers/trigger-1 {"enabled":false}`))
+	})
+})
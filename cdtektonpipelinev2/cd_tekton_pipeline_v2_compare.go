@@ -0,0 +1,493 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultDiffContext is the number of unchanged lines shown around each change when CompareRunsOptions.Context is
+// not set.
+const DefaultDiffContext = 3
+
+// Constants associated with the RunDifference.Kind property.
+const (
+	RunDifferenceKindChangedConst = "changed"
+	RunDifferenceKindAddedConst   = "added"
+	RunDifferenceKindRemovedConst = "removed"
+)
+
+// CompareRunsOptions : Options for CompareTektonPipelineRuns.
+type CompareRunsOptions struct {
+	// Produce a line diff of the first step log whose content differs between the runs.
+	DiffLogs bool
+
+	// The number of unchanged lines shown around each change in the unified diffs.
+	Context int
+}
+
+// RunDifference : A single field that differs between two pipeline runs.
+type RunDifference struct {
+	// The field, for example `properties.env`, `worker.id`, `trigger.name`, `event.repository.name` or
+	// `logs.<name>`.
+	Field string `json:"field"`
+
+	// `changed`, `added` (only in run B) or `removed` (only in run A).
+	Kind string `json:"kind"`
+
+	// The value in run A.
+	A string `json:"a,omitempty"`
+
+	// The value in run B.
+	B string `json:"b,omitempty"`
+}
+
+// LogDiff : The line diff of a step log between two pipeline runs.
+type LogDiff struct {
+	// The name of the log, with the run ID replaced by `<run>`.
+	Name string `json:"name"`
+
+	// The unified diff of the log content.
+	Unified string `json:"unified"`
+}
+
+// RunComparison : The differences between two pipeline runs.
+type RunComparison struct {
+	// The ID of run A.
+	RunA string `json:"run_a"`
+
+	// The ID of run B.
+	RunB string `json:"run_b"`
+
+	// The differences, sorted by field.
+	Differences []RunDifference `json:"differences"`
+
+	// The diff of the first step log whose content differs, when requested and found.
+	LogDiff *LogDiff `json:"log_diff,omitempty"`
+
+	// The unified diff text of the compared fields, followed by the log diff.
+	Unified string `json:"unified"`
+}
+
+// CompareTektonPipelineRuns compares two runs of a pipeline: their properties, definition, worker, trigger, decoded
+// event parameters and the names of their step logs. Step log names are compared with the run ID replaced by `<run>`,
+// since Tekton derives pod names from the run. With DiffLogs, the content of the logs present in both runs is
+// compared in run A's order and the first one that differs is diffed line by line.
+func (cdTektonPipeline *CdTektonPipelineV2) CompareTektonPipelineRuns(ctx context.Context, pipelineID string, runA string, runB string, options *CompareRunsOptions) (comparison *RunComparison, err error) {
+	if options == nil {
+		options = &CompareRunsOptions{}
+	}
+	diffContext := options.Context
+	if diffContext <= 0 {
+		diffContext = DefaultDiffContext
+	}
+
+	var runs [2]*PipelineRun
+	var logs [2][]Log
+	for i, runID := range []string{runA, runB} {
+		runs[i], _, err = cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineRunOptions(pipelineID, runID))
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "compare-get-run-error")
+			return
+		}
+		var collection *LogsCollection
+		collection, _, err = cdTektonPipeline.GetTektonPipelineRunLogsWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineRunLogsOptions(pipelineID, runID))
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "compare-get-logs-error")
+			return
+		}
+		logs[i] = collection.Logs
+	}
+
+	fieldsA := runFields(runs[0], runA, logs[0])
+	fieldsB := runFields(runs[1], runB, logs[1])
+	comparison = &RunComparison{
+		RunA:        runA,
+		RunB:        runB,
+		Differences: []RunDifference{},
+	}
+	for field, a := range fieldsA {
+		b, ok := fieldsB[field]
+		switch {
+		case !ok:
+			comparison.Differences = append(comparison.Differences, RunDifference{Field: field, Kind: RunDifferenceKindRemovedConst, A: a})
+		case a != b:
+			comparison.Differences = append(comparison.Differences, RunDifference{Field: field, Kind: RunDifferenceKindChangedConst, A: a, B: b})
+		}
+	}
+	for field, b := range fieldsB {
+		if _, ok := fieldsA[field]; !ok {
+			comparison.Differences = append(comparison.Differences, RunDifference{Field: field, Kind: RunDifferenceKindAddedConst, B: b})
+		}
+	}
+	sort.Slice(comparison.Differences, func(i, j int) bool {
+		return comparison.Differences[i].Field < comparison.Differences[j].Field
+	})
+
+	var unified strings.Builder
+	unified.WriteString(unifiedDiff("run "+runA, "run "+runB, fieldLines(fieldsA), fieldLines(fieldsB), diffContext))
+
+	if options.DiffLogs {
+		comparison.LogDiff, err = cdTektonPipeline.firstLogDiff(ctx, pipelineID, [2]string{runA, runB}, logs, diffContext)
+		if err != nil {
+			return
+		}
+		if comparison.LogDiff != nil {
+			unified.WriteString(comparison.LogDiff.Unified)
+		}
+	}
+	comparison.Unified = unified.String()
+	return
+}
+
+// firstLogDiff diffs the first log, in the order of run A, that is present in both runs with different content.
+func (cdTektonPipeline *CdTektonPipelineV2) firstLogDiff(ctx context.Context, pipelineID string, runIDs [2]string, logs [2][]Log, diffContext int) (*LogDiff, error) {
+	logsB := map[string]Log{}
+	for _, log := range logs[1] {
+		logsB[normalizedLogName(log, runIDs[1])] = log
+	}
+	for _, logA := range logs[0] {
+		name := normalizedLogName(logA, runIDs[0])
+		logB, ok := logsB[name]
+		if !ok || logA.ID == nil || logB.ID == nil {
+			continue
+		}
+		var content [2]string
+		for i, log := range []Log{logA, logB} {
+			stepLog, _, err := cdTektonPipeline.GetTektonPipelineRunLogContentWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineRunLogContentOptions(pipelineID, runIDs[i], *log.ID))
+			if err != nil {
+				return nil, core.RepurposeSDKProblem(err, "compare-get-log-content-error")
+			}
+			content[i] = core.StringNilMapper(stepLog.Data)
+		}
+		if content[0] == content[1] {
+			continue
+		}
+		return &LogDiff{
+			Name:    name,
+			Unified: unifiedDiff(runIDs[0]+"/"+name, runIDs[1]+"/"+name, splitLines(content[0]), splitLines(content[1]), diffContext),
+		}, nil
+	}
+	return nil, nil
+}
+
+// runFields flattens the compared parts of a run into field names and values.
+func runFields(run *PipelineRun, runID string, logs []Log) map[string]string {
+	fields := map[string]string{}
+	for _, property := range run.Properties {
+		if property.Name == nil {
+			continue
+		}
+		prefix := "properties." + *property.Name
+		fields[prefix] = core.StringNilMapper(property.Value)
+		fields[prefix+".type"] = core.StringNilMapper(property.Type)
+		if property.Path != nil {
+			fields[prefix+".path"] = *property.Path
+		}
+	}
+	if run.Definition != nil && run.Definition.ID != nil {
+		fields["definition.id"] = *run.Definition.ID
+	} else if run.DefinitionID != nil {
+		fields["definition.id"] = *run.DefinitionID
+	}
+	if run.Worker != nil {
+		flattenValue("worker", run.Worker, fields)
+	}
+	if trigger := AsTrigger(run.Trigger); trigger != nil {
+		flattenValue("trigger", trigger, fields)
+		delete(fields, "trigger.href")
+	}
+	if run.ListenerName != nil {
+		fields["listener_name"] = *run.ListenerName
+	}
+	if blob := core.StringNilMapper(run.EventParamsBlob); blob != "" {
+		var event interface{}
+		if json.Unmarshal([]byte(blob), &event) == nil {
+			flattenJSON("event", event, fields)
+		} else {
+			fields["event"] = blob
+		}
+	}
+	for _, log := range logs {
+		fields["logs."+normalizedLogName(log, runID)] = "present"
+	}
+	return fields
+}
+
+// flattenValue flattens the JSON representation of a value.
+func flattenValue(prefix string, value interface{}, fields map[string]string) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	var decoded interface{}
+	if json.Unmarshal(data, &decoded) == nil {
+		flattenJSON(prefix, decoded, fields)
+	}
+}
+
+// flattenJSON adds a field for every scalar in a decoded JSON value, named by its path.
+func flattenJSON(prefix string, value interface{}, fields map[string]string) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, element := range typed {
+			flattenJSON(prefix+"."+key, element, fields)
+		}
+	case []interface{}:
+		for i, element := range typed {
+			flattenJSON(fmt.Sprintf("%s[%d]", prefix, i), element, fields)
+		}
+	case string:
+		fields[prefix] = typed
+	case nil:
+		fields[prefix] = "null"
+	default:
+		data, _ := json.Marshal(typed)
+		fields[prefix] = string(data)
+	}
+}
+
+// normalizedLogName returns the name of a log with the run ID replaced by `<run>`.
+func normalizedLogName(log Log, runID string) string {
+	name := core.StringNilMapper(log.Name)
+	if runID != "" {
+		name = strings.ReplaceAll(name, runID, "<run>")
+	}
+	return name
+}
+
+// fieldLines renders fields as sorted `field = value` lines.
+func fieldLines(fields map[string]string) []string {
+	lines := make([]string, 0, len(fields))
+	for field, value := range fields {
+		lines = append(lines, field+" = "+value)
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffEdit : A line of a diff. Op is ' ' for an unchanged line, '-' for a line of a only and '+' for a line of b only.
+// I and J are the positions in a and b before the line.
+type diffEdit struct {
+	op   byte
+	line string
+	i, j int
+}
+
+// unifiedDiff renders the line diff of a and b in unified format, with diffContext unchanged lines around each
+// change. It returns "" when a and b are equal.
+func unifiedDiff(nameA string, nameB string, a []string, b []string, diffContext int) string {
+	var edits []diffEdit
+	diffLines(a, b, &edits)
+	// Within each change, list the removed lines before the added ones, then number the lines.
+	for start := 0; start < len(edits); start++ {
+		end := start
+		for end < len(edits) && edits[end].op != ' ' {
+			end++
+		}
+		sort.SliceStable(edits[start:end], func(x, y int) bool {
+			return edits[start+x].op == '-' && edits[start+y].op == '+'
+		})
+		start = end
+	}
+	i, j := 0, 0
+	for k := range edits {
+		edits[k].i, edits[k].j = i, j
+		if edits[k].op != '+' {
+			i++
+		}
+		if edits[k].op != '-' {
+			j++
+		}
+	}
+
+	var out strings.Builder
+	for start := 0; start < len(edits); {
+		if edits[start].op == ' ' {
+			start++
+			continue
+		}
+		// Extend the hunk while the next change is within 2*diffContext unchanged lines.
+		first := start - diffContext
+		if first < 0 {
+			first = 0
+		}
+		end := start
+		for k := start; k < len(edits) && k-end <= 2*diffContext; k++ {
+			if edits[k].op != ' ' {
+				end = k
+			}
+		}
+		last := end + diffContext
+		if last >= len(edits) {
+			last = len(edits) - 1
+		}
+
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- %s\n+++ %s\n", nameA, nameB)
+		}
+		countA, countB := 0, 0
+		for _, e := range edits[first : last+1] {
+			if e.op != '+' {
+				countA++
+			}
+			if e.op != '-' {
+				countB++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(edits[first].i, countA), hunkRange(edits[first].j, countB))
+		for _, e := range edits[first : last+1] {
+			out.WriteByte(e.op)
+			out.WriteString(e.line)
+			out.WriteByte('\n')
+		}
+		start = last + 1
+	}
+	return out.String()
+}
+
+// diffLines appends the edits that turn a into b to edits, using the linear space variant of the Myers diff algorithm.
+func diffLines(a []string, b []string, edits *[]diffEdit) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	for _, line := range a[:prefix] {
+		*edits = append(*edits, diffEdit{op: ' ', line: line})
+	}
+
+	middleA, middleB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	x, y, ok := middleSnake(middleA, middleB)
+	if ok && (x > 0 || y > 0) && (x < len(middleA) || y < len(middleB)) {
+		diffLines(middleA[:x], middleB[:y], edits)
+		diffLines(middleA[x:], middleB[y:], edits)
+	} else {
+		for _, line := range middleA {
+			*edits = append(*edits, diffEdit{op: '-', line: line})
+		}
+		for _, line := range middleB {
+			*edits = append(*edits, diffEdit{op: '+', line: line})
+		}
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		*edits = append(*edits, diffEdit{op: ' ', line: line})
+	}
+}
+
+// middleSnake runs the Myers search from both ends of a and b at the same time and returns the point where the two
+// paths meet, which splits the diff into two independent halves. It returns false when a and b have no line in common.
+func middleSnake(a []string, b []string) (x int, y int, ok bool) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return
+	}
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for k := range forward {
+		forward[k], backward[k] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+	delta := n - m
+	// The paths meet while extending the forward path if delta is odd, and the backward path otherwise.
+	odd := delta%2 != 0
+	forwardStart, forwardEnd, backwardStart, backwardEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + forwardStart; k <= d-forwardEnd; k += 2 {
+			var x1 int
+			if k == -d || k != d && forward[offset+k-1] < forward[offset+k+1] {
+				x1 = forward[offset+k+1]
+			} else {
+				x1 = forward[offset+k-1] + 1
+			}
+			y1 := x1 - k
+			for x1 < n && y1 < m && a[x1] == b[y1] {
+				x1++
+				y1++
+			}
+			forward[offset+k] = x1
+			switch {
+			case x1 > n:
+				forwardEnd += 2
+			case y1 > m:
+				forwardStart += 2
+			case odd:
+				if other := offset + delta - k; other >= 0 && other < len(backward) && backward[other] != -1 && x1 >= n-backward[other] {
+					return x1, y1, true
+				}
+			}
+		}
+		for k := -d + backwardStart; k <= d-backwardEnd; k += 2 {
+			var x2 int
+			if k == -d || k != d && backward[offset+k-1] < backward[offset+k+1] {
+				x2 = backward[offset+k+1]
+			} else {
+				x2 = backward[offset+k-1] + 1
+			}
+			y2 := x2 - k
+			for x2 < n && y2 < m && a[n-x2-1] == b[m-y2-1] {
+				x2++
+				y2++
+			}
+			backward[offset+k] = x2
+			switch {
+			case x2 > n:
+				backwardEnd += 2
+			case y2 > m:
+				backwardStart += 2
+			case !odd:
+				if other := offset + delta - k; other >= 0 && other < len(forward) && forward[other] != -1 {
+					x1 := forward[other]
+					if x1 >= n-x2 {
+						return x1, x1 - (other - offset), true
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+// hunkRange renders the start and length of a hunk in unified format, where lines are numbered from 1.
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CompareTektonPipelineRuns(ctx, pipelineID, runA, runB, options)`, func() {
+	var testServer *httptest.Server
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-341":
+				fmt.Fprintf(res, "%s", `{"id": "run-341", "status": "succeeded", "definition_id": "def-1",
+					"worker": {"id": "public", "name": "IBM Managed workers"},
+					"trigger": {"type": "manual", "name": "ci"},
+					"event_params_blob": "{\"ref\": \"refs/heads/main\", \"commits\": [{\"id\": \"a1\"}]}",
+					"properties": [{"name": "env", "type": "text", "value": "dev"}, {"name": "debug", "type": "text", "value": "false"}]}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-342":
+				fmt.Fprintf(res, "%s", `{"id": "run-342", "status": "failed", "definition_id": "def-1",
+					"worker": {"id": "worker-2", "name": "private"},
+					"trigger": {"type": "manual", "name": "ci"},
+					"event_params_blob": "{\"ref\": \"refs/heads/main\", \"commits\": [{\"id\": \"b2\"}]}",
+					"properties": [{"name": "env", "type": "text", "value": "prod"}, {"name": "region", "type": "text", "value": "eu-de"}]}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-341/logs":
+				fmt.Fprintf(res, "%s", `{"logs": [
+					{"id": "log-a1", "name": "run-341-build-pod/step-clone"},
+					{"id": "log-a2", "name": "run-341-build-pod/step-build"},
+					{"id": "log-a3", "name": "run-341-build-pod/step-publish"}]}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-342/logs":
+				fmt.Fprintf(res, "%s", `{"logs": [
+					{"id": "log-b1", "name": "run-342-build-pod/step-clone"},
+					{"id": "log-b2", "name": "run-342-build-pod/step-build"}]}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-341/logs/log-a1",
+				"GET /tekton_pipelines/pipeline-1/pipeline_runs/run-342/logs/log-b1":
+				fmt.Fprintf(res, "%s", `{"id": "log", "data": "cloning\ndone\n"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-341/logs/log-a2":
+				fmt.Fprintf(res, "%s", `{"id": "log-a2", "data": "1\n2\n3\n4\n5\n6\n7\n8\nok\n"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-342/logs/log-b2":
+				fmt.Fprintf(res, "%s", `{"id": "log-b2", "data": "1\n2\n3\n4\n5\n6\n7\n8\nerror: exit 1\n"}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	It(`Returns the structured differences and a unified diff`, func() {
+		comparison, err := newService().CompareTektonPipelineRuns(context.Background(), "pipeline-1", "run-341", "run-342", nil)
+		Expect(err).To(BeNil())
+		Expect(comparison.LogDiff).To(BeNil())
+		fields := map[string]cdtektonpipelinev2.RunDifference{}
+		for _, difference := range comparison.Differences {
+			fields[difference.Field] = difference
+		}
+		Expect(fields).To(HaveLen(9))
+		Expect(fields["properties.env"]).To(Equal(cdtektonpipelinev2.RunDifference{Field: "properties.env", Kind: "changed", A: "dev", B: "prod"}))
+		Expect(fields["properties.debug"].Kind).To(Equal("removed"))
+		Expect(fields["properties.region"].Kind).To(Equal("added"))
+		Expect(fields["worker.id"].B).To(Equal("worker-2"))
+		Expect(fields["worker.name"].A).To(Equal("IBM Managed workers"))
+		Expect(fields["event.commits[0].id"].B).To(Equal("b2"))
+		Expect(fields["logs.<run>-build-pod/step-publish"].Kind).To(Equal("removed"))
+		Expect(fields).ToNot(HaveKey("definition.id"))
+		Expect(fields).ToNot(HaveKey("trigger.name"))
+		Expect(comparison.Unified).To(HavePrefix("--- run run-341\n+++ run run-342\n@@ "))
+		Expect(comparison.Unified).To(ContainSubstring("-properties.env = dev\n+properties.env = prod\n"))
+	})
+	It(`Diffs the first diverging step log`, func() {
+		comparison, err := newService().CompareTektonPipelineRuns(context.Background(), "pipeline-1", "run-341", "run-342", &cdtektonpipelinev2.CompareRunsOptions{
+			DiffLogs: true,
+			Context:  2,
+		})
+		Expect(err).To(BeNil())
+		Expect(comparison.LogDiff).ToNot(BeNil())
+		Expect(comparison.LogDiff.Name).To(Equal("<run>-build-pod/step-build"))
+		Expect(comparison.LogDiff.Unified).To(Equal("--- run-341/<run>-build-pod/step-build\n" +
+			"+++ run-342/<run>-build-pod/step-build\n" +
+			"@@ -7,3 +7,3 @@\n" +
+			" 7\n" +
+			" 8\n" +
+			"-ok\n" +
+			"+error: exit 1\n"))
+		Expect(comparison.Unified).To(HaveSuffix(comparison.LogDiff.Unified))
+	})
+})
@@ -0,0 +1,230 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultFailureExcerptLines is the number of log lines included in the excerpt of a failed step when
+// FailureAnalysisOptions.ExcerptLines is not set.
+const DefaultFailureExcerptLines = 20
+
+// FailureAnalysisOptions : Options for AnalyzeTektonPipelineRunFailure.
+type FailureAnalysisOptions struct {
+	// The number of log lines included in the excerpt of each step.
+	ExcerptLines int
+}
+
+// FailureSignature : A named pattern that identifies an error line in a step log.
+type FailureSignature struct {
+	// The name reported when the pattern matches.
+	Name string
+
+	// The pattern matched against each log line.
+	Pattern *regexp.Regexp
+}
+
+// DefaultFailureSignatures are the error signatures AnalyzeTektonPipelineRunFailure looks for.
+var DefaultFailureSignatures = []FailureSignature{
+	{Name: "error", Pattern: regexp.MustCompile(`(?i)^\s*(\[?error\]?|fatal|err!)[:\s]`)},
+	{Name: "panic", Pattern: regexp.MustCompile(`^panic: `)},
+	{Name: "exception", Pattern: regexp.MustCompile(`Exception in thread|^\w+(\.\w+)*(Exception|Error): `)},
+	{Name: "traceback", Pattern: regexp.MustCompile(`^Traceback \(most recent call last\)`)},
+	{Name: "npm", Pattern: regexp.MustCompile(`^npm ERR! `)},
+	{Name: "out-of-memory", Pattern: regexp.MustCompile(`(?i)out of memory|OOMKilled`)},
+	{Name: "command-not-found", Pattern: regexp.MustCompile(`command not found`)},
+	{Name: "permission-denied", Pattern: regexp.MustCompile(`(?i)permission denied`)},
+	{Name: "timeout", Pattern: regexp.MustCompile(`(?i)\btimed out\b|deadline exceeded`)},
+}
+
+// exitMarkerPattern matches the lines that report a non-zero exit code.
+var exitMarkerPattern = regexp.MustCompile(`(?i)(?:exit(?:ed)?(?: with)?(?: status| code)|returned exit code|terminated with exit code)[:\s]+([1-9][0-9]*)`)
+
+// FailedStep : A step of a failed run whose log shows signs of failure.
+type FailedStep struct {
+	// The name of the step log, `<podName>/<containerName>`.
+	LogName string `json:"log_name"`
+
+	// The pod of the step.
+	Pod string `json:"pod"`
+
+	// The container of the step.
+	Container string `json:"container"`
+
+	// The non-zero exit code reported in the log, if any.
+	ExitCode *int `json:"exit_code,omitempty"`
+
+	// The names of the error signatures found in the log.
+	Signatures []string `json:"signatures,omitempty"`
+
+	// The lines that matched an error signature or exit marker, in order.
+	ErrorLines []string `json:"error_lines,omitempty"`
+
+	// The lines of the log around its first error line, or the last lines of the log when it has none.
+	Excerpt string `json:"excerpt"`
+}
+
+// FailureReport : Explains why a pipeline run failed.
+type FailureReport struct {
+	// The ID of the run.
+	RunID string `json:"run_id"`
+
+	// The status of the run, `failed` or `error`.
+	Status string `json:"status"`
+
+	// The error message of the run.
+	ErrorMessage string `json:"error_message,omitempty"`
+
+	// The URL of the run's details page.
+	RunURL string `json:"run_url,omitempty"`
+
+	// The step that most likely caused the failure: the first one with a non-zero exit code, otherwise the first one
+	// with an error signature, otherwise the last step. Nil if the run has no step logs.
+	FailingStep *FailedStep `json:"failing_step,omitempty"`
+
+	// Every step with a non-zero exit code or an error signature, in log order.
+	FailedSteps []FailedStep `json:"failed_steps"`
+}
+
+// Summary returns a one-line description of the failure, suitable for chat messages.
+func (report *FailureReport) Summary() string {
+	summary := fmt.Sprintf("Pipeline run %s %s", report.RunID, report.Status)
+	if step := report.FailingStep; step != nil {
+		summary += " in " + step.LogName
+		if step.ExitCode != nil {
+			summary += fmt.Sprintf(" (exit code %d)", *step.ExitCode)
+		}
+		if len(step.ErrorLines) > 0 {
+			summary += ": " + step.ErrorLines[0]
+		}
+	} else if report.ErrorMessage != "" {
+		summary += ": " + report.ErrorMessage
+	}
+	return summary
+}
+
+// AnalyzeTektonPipelineRunFailure fetches the step logs of a failed or errored run and scans them for non-zero exit
+// markers and DefaultFailureSignatures to pinpoint the failing step. It returns an error if the run did not fail.
+// The options may be nil.
+func (cdTektonPipeline *CdTektonPipelineV2) AnalyzeTektonPipelineRunFailure(ctx context.Context, pipelineID string, runID string, options *FailureAnalysisOptions) (report *FailureReport, err error) {
+	excerptLines := DefaultFailureExcerptLines
+	if options != nil && options.ExcerptLines > 0 {
+		excerptLines = options.ExcerptLines
+	}
+	run, _, err := cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineRunOptions(pipelineID, runID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "analyze-get-run-error")
+		return
+	}
+	status := core.StringNilMapper(run.Status)
+	if status != PipelineRunStatusFailedConst && status != PipelineRunStatusErrorConst {
+		err = core.SDKErrorf(nil, fmt.Sprintf("pipeline run %s is %s, not failed", runID, status), "run-not-failed", common.GetComponentInfo())
+		return
+	}
+	stepLogs, err := cdTektonPipeline.getStepLogs(ctx, pipelineID, runID)
+	if err != nil {
+		return
+	}
+
+	report = &FailureReport{
+		RunID:        runID,
+		Status:       status,
+		ErrorMessage: core.StringNilMapper(run.ErrorMessage),
+		RunURL:       core.StringNilMapper(run.RunURL),
+		FailedSteps:  []FailedStep{},
+	}
+	var last *FailedStep
+	for _, entry := range stepLogs {
+		step := analyzeStepLog(core.StringNilMapper(entry.Log.Name), entry.Data, excerptLines)
+		last = &step
+		if step.ExitCode != nil || len(step.Signatures) > 0 {
+			report.FailedSteps = append(report.FailedSteps, step)
+		}
+	}
+	for i := range report.FailedSteps {
+		if report.FailedSteps[i].ExitCode != nil {
+			report.FailingStep = &report.FailedSteps[i]
+			break
+		}
+	}
+	if report.FailingStep == nil && len(report.FailedSteps) > 0 {
+		report.FailingStep = &report.FailedSteps[0]
+	}
+	if report.FailingStep == nil {
+		report.FailingStep = last
+	}
+	return
+}
+
+// analyzeStepLog scans the content of a single step log. The excerpt starts shortly before the first error line, so
+// that it shows the cause of the failure rather than the cleanup that follows it.
+func analyzeStepLog(name string, data string, excerptLines int) (step FailedStep) {
+	step.LogName = name
+	step.Pod, step.Container = SplitLogName(name)
+	lines := splitLines(data)
+	seen := map[string]bool{}
+	firstError := -1
+	for i, line := range lines {
+		matched := false
+		if match := exitMarkerPattern.FindStringSubmatch(line); match != nil {
+			if code, err := strconv.Atoi(match[1]); err == nil {
+				step.ExitCode = &code
+				matched = true
+			}
+		}
+		for _, signature := range DefaultFailureSignatures {
+			if signature.Pattern.MatchString(line) {
+				matched = true
+				if !seen[signature.Name] {
+					seen[signature.Name] = true
+					step.Signatures = append(step.Signatures, signature.Name)
+				}
+			}
+		}
+		if matched {
+			step.ErrorLines = append(step.ErrorLines, strings.TrimSpace(line))
+			if firstError < 0 {
+				firstError = i
+			}
+		}
+	}
+	end := len(lines)
+	if firstError >= 0 {
+		// Keep a quarter of the excerpt for the lines leading up to the error.
+		end = firstError - excerptLines/4 + excerptLines
+		if end < firstError+1 {
+			end = firstError + 1
+		}
+		if end > len(lines) {
+			end = len(lines)
+		}
+	}
+	start := end - excerptLines
+	if start < 0 {
+		start = 0
+	}
+	step.Excerpt = strings.Join(lines[start:end], "\n")
+	return
+}
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AnalyzeTektonPipelineRunFailure(ctx, pipelineID, runID, options)`, func() {
+	var testServer *httptest.Server
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-1":
+				fmt.Fprintf(res, "%s", `{"id": "run-1", "status": "failed", "error_message": "Task build failed", "run_url": "https://cloud.ibm.com/run-1"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-2":
+				fmt.Fprintf(res, "%s", `{"id": "run-2", "status": "succeeded"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-1/logs":
+				fmt.Fprintf(res, "%s", `{"logs": [
+					{"id": "log-1", "name": "run-1-build-pod/step-lint"},
+					{"id": "log-2", "name": "run-1-build-pod/step-build"},
+					{"id": "log-3", "name": "run-1-build-pod/step-publish"}]}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-1/logs/log-1":
+				fmt.Fprintf(res, "%s", `{"id": "log-1", "data": "linting\nwarning: unused variable\nERROR: style check failed, continuing\n"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-1/logs/log-2":
+				fmt.Fprintf(res, "%s", `{"id": "log-2", "data": "compiling\nnpm ERR! missing script: build\nnpm ERR! A complete log of this run can be found\nscript exited with code 1\n"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-1/logs/log-3":
+				fmt.Fprintf(res, "%s", `{"id": "log-3", "data": ""}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-3":
+				fmt.Fprintf(res, "%s", `{"id": "run-3", "status": "error"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-3/logs":
+				fmt.Fprintf(res, "%s", `{"logs": [{"id": "log-1", "name": "run-3-deploy-pod/step-deploy"}]}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-3/logs/log-1":
+				var data strings.Builder
+				for i := 0; i < 30; i++ {
+					if i == 10 {
+						data.WriteString(`Error: connection refused\n`)
+					} else {
+						fmt.Fprintf(&data, `line %d\n`, i)
+					}
+				}
+				fmt.Fprintf(res, `{"id": "log-1", "data": "%s"}`, data.String())
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	It(`Pinpoints the step with a non-zero exit code`, func() {
+		report, err := newService().AnalyzeTektonPipelineRunFailure(context.Background(), "pipeline-1", "run-1", nil)
+		Expect(err).To(BeNil())
+		Expect(report.ErrorMessage).To(Equal("Task build failed"))
+		Expect(report.FailedSteps).To(HaveLen(2))
+		Expect(report.FailedSteps[0].Container).To(Equal("step-lint"))
+		Expect(report.FailedSteps[0].Signatures).To(Equal([]string{"error"}))
+		Expect(report.FailedSteps[0].ExitCode).To(BeNil())
+
+		step := report.FailingStep
+		Expect(step).ToNot(BeNil())
+		Expect(step.Pod).To(Equal("run-1-build-pod"))
+		Expect(step.Container).To(Equal("step-build"))
+		Expect(*step.ExitCode).To(Equal(1))
+		Expect(step.Signatures).To(Equal([]string{"npm"}))
+		Expect(step.ErrorLines).To(HaveLen(3))
+		Expect(strings.Split(step.Excerpt, "\n")).To(HaveLen(4))
+		Expect(report.Summary()).To(Equal("Pipeline run run-1 failed in run-1-build-pod/step-build (exit code 1): npm ERR! missing script: build"))
+	})
+	It(`Anchors the excerpt on the first error line`, func() {
+		report, err := newService().AnalyzeTektonPipelineRunFailure(context.Background(), "pipeline-1", "run-3", &cdtektonpipelinev2.FailureAnalysisOptions{
+			ExcerptLines: 8,
+		})
+		Expect(err).To(BeNil())
+		excerpt := strings.Split(report.FailingStep.Excerpt, "\n")
+		Expect(excerpt).To(HaveLen(8))
+		Expect(excerpt[0]).To(Equal("line 8"))
+		Expect(excerpt[2]).To(Equal("Error: connection refused"))
+		Expect(excerpt[7]).To(Equal("line 15"))
+
+		report, err = newService().AnalyzeTektonPipelineRunFailure(context.Background(), "pipeline-1", "run-3", nil)
+		Expect(err).To(BeNil())
+		excerpt = strings.Split(report.FailingStep.Excerpt, "\n")
+		Expect(excerpt).To(HaveLen(cdtektonpipelinev2.DefaultFailureExcerptLines))
+		Expect(excerpt[0]).To(Equal("line 5"))
+	})
+	It(`Rejects runs that did not fail`, func() {
+		_, err := newService().AnalyzeTektonPipelineRunFailure(context.Background(), "pipeline-1", "run-2", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("is succeeded, not failed"))
+	})
+})
@@ -0,0 +1,111 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AsTrigger returns the generic Trigger representation of any of the trigger models. Triggers unmarshalled from API
+// responses are already of type *Trigger and are returned as is; the typed trigger models are converted.
+func AsTrigger(trigger TriggerIntf) *Trigger {
+	switch typed := trigger.(type) {
+	case nil:
+		return nil
+	case *Trigger:
+		return typed
+	}
+	converted := new(Trigger)
+	data, err := json.Marshal(trigger)
+	if err != nil || json.Unmarshal(data, converted) != nil {
+		return nil
+	}
+	return converted
+}
+
+// IsTerminalPipelineRunStatus returns true if a pipeline run with the specified status has finished and will not change
+// status again.
+func IsTerminalPipelineRunStatus(status string) bool {
+	switch status {
+	case PipelineRunStatusSucceededConst, PipelineRunStatusFailedConst, PipelineRunStatusErrorConst, PipelineRunStatusCancelledConst:
+		return true
+	}
+	return false
+}
+
+// SplitLogName splits the name of a step log, of the form `<podName>/<containerName>`, into the pod and container
+// names. A name without a `/` is returned as the container name.
+func SplitLogName(name string) (pod string, container string) {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// findTrigger returns the trigger of a pipeline with the specified name.
+func (cdTektonPipeline *CdTektonPipelineV2) findTrigger(ctx context.Context, pipelineID string, triggerName string) (trigger *Trigger, err error) {
+	triggers, _, err := cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, cdTektonPipeline.NewListTektonPipelineTriggersOptions(pipelineID).SetName(triggerName))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-triggers-error")
+		return
+	}
+	for _, triggerIntf := range triggers.Triggers {
+		if candidate := AsTrigger(triggerIntf); candidate != nil && core.StringNilMapper(candidate.Name) == triggerName {
+			trigger = candidate
+			return
+		}
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("trigger %s not found in pipeline %s", triggerName, pipelineID), "trigger-not-found", common.GetComponentInfo())
+	return
+}
+
+// stepLog : A step log entry together with its content.
+type stepLog struct {
+	Log  Log
+	Data string
+}
+
+// getStepLogs fetches the list of step logs of a pipeline run and the content of each of them, in the order listed.
+func (cdTektonPipeline *CdTektonPipelineV2) getStepLogs(ctx context.Context, pipelineID string, runID string) (stepLogs []stepLog, err error) {
+	logs, _, err := cdTektonPipeline.GetTektonPipelineRunLogsWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineRunLogsOptions(pipelineID, runID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-logs-error")
+		return
+	}
+	for _, log := range logs.Logs {
+		if log.ID == nil {
+			continue
+		}
+		content, _, contentErr := cdTektonPipeline.GetTektonPipelineRunLogContentWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineRunLogContentOptions(pipelineID, runID, *log.ID))
+		if contentErr != nil {
+			err = core.RepurposeSDKProblem(contentErr, "get-log-content-error")
+			return
+		}
+		entry := stepLog{Log: log}
+		if content.Data != nil {
+			entry.Data = *content.Data
+		}
+		stepLogs = append(stepLogs, entry)
+	}
+	return
+}
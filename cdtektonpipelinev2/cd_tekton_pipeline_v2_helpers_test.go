@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 helpers`, func() {
+	Describe(`AsTrigger(trigger TriggerIntf)`, func() {
+		It(`Returns generic triggers as is`, func() {
+			trigger := &cdtektonpipelinev2.Trigger{Name: core.StringPtr("manual")}
+			Expect(cdtektonpipelinev2.AsTrigger(trigger)).To(BeIdenticalTo(trigger))
+		})
+		It(`Converts typed triggers`, func() {
+			trigger := cdtektonpipelinev2.AsTrigger(&cdtektonpipelinev2.TriggerTimerTrigger{
+				Type:              core.StringPtr("timer"),
+				Name:              core.StringPtr("nightly"),
+				Enabled:           core.BoolPtr(true),
+				MaxConcurrentRuns: core.Int64Ptr(2),
+				Cron:              core.StringPtr("0 2 * * *"),
+			})
+			Expect(trigger).ToNot(BeNil())
+			Expect(*trigger.Name).To(Equal("nightly"))
+			Expect(*trigger.Enabled).To(BeTrue())
+			Expect(*trigger.MaxConcurrentRuns).To(Equal(int64(2)))
+			Expect(*trigger.Cron).To(Equal("0 2 * * *"))
+		})
+		It(`Returns nil for nil`, func() {
+			Expect(cdtektonpipelinev2.AsTrigger(nil)).To(BeNil())
+		})
+	})
+	Describe(`IsTerminalPipelineRunStatus(status string)`, func() {
+		It(`Recognises terminal statuses`, func() {
+			Expect(cdtektonpipelinev2.IsTerminalPipelineRunStatus("succeeded")).To(BeTrue())
+			Expect(cdtektonpipelinev2.IsTerminalPipelineRunStatus("cancelled")).To(BeTrue())
+			Expect(cdtektonpipelinev2.IsTerminalPipelineRunStatus("waiting")).To(BeFalse())
+			Expect(cdtektonpipelinev2.IsTerminalPipelineRunStatus("")).To(BeFalse())
+		})
+	})
+	Describe(`SplitLogName(name string)`, func() {
+		It(`Splits pod and container names`, func() {
+			pod, container := cdtektonpipelinev2.SplitLogName("run-1-build-pod/step-build")
+			Expect(pod).To(Equal("run-1-build-pod"))
+			Expect(container).To(Equal("step-build"))
+			pod, container = cdtektonpipelinev2.SplitLogName("step-build")
+			Expect(pod).To(Equal(""))
+			Expect(container).To(Equal("step-build"))
+		})
+	})
+})
@@ -0,0 +1,301 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the LogLine.Stream property.
+const (
+	LogLineStreamStdoutConst = "stdout"
+	LogLineStreamStderrConst = "stderr"
+)
+
+// LogLine : A single line of a step log.
+type LogLine struct {
+	// The line number within the step log, starting at 1.
+	Number int `json:"number"`
+
+	// The timestamp at the start of the line, if any.
+	Time *time.Time `json:"time,omitempty"`
+
+	// The output stream of the line, `stdout` unless the log is in the Kubernetes CRI format and says otherwise.
+	Stream string `json:"stream"`
+
+	// The text of the line without its timestamp and ANSI escapes.
+	Text string `json:"text"`
+
+	// The styled segments of the text, present only when the line contained ANSI colours or styles.
+	Segments []LogSegment `json:"segments,omitempty"`
+
+	// The step, that is the container name of the log.
+	Step string `json:"step"`
+
+	// The pod of the step.
+	Pod string `json:"pod,omitempty"`
+
+	// The section the line belongs to, started by a `::group::<name>` line and ended by `::endgroup::`.
+	Section string `json:"section,omitempty"`
+}
+
+// LogSegment : A run of text with the same ANSI style.
+type LogSegment struct {
+	// The text of the segment.
+	Text string `json:"text"`
+
+	// CSS classes for the style of the segment, such as `ansi-bold` or `ansi-fg-red`.
+	Classes []string `json:"classes,omitempty"`
+}
+
+var (
+	logTimestampPattern = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:Z|[+-]\d{2}:\d{2}))(?: (stdout|stderr) [FP])?(?: |$)`)
+	ansiEscapePattern   = regexp.MustCompile("\x1b\\[([0-9;?]*)([A-Za-z])|\x1b\\][^\x07\x1b]*(?:\x07|\x1b\\\\)")
+	ansiColorNames      = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+)
+
+// ParseStepLog splits the content of a step log into lines. Leading RFC 3339 timestamps, including the stream of the
+// Kubernetes CRI log format, are parsed and removed from the text. ANSI escapes are removed from the text and
+// converted into styled segments. Carriage returns overwrite the line, as a terminal would.
+func ParseStepLog(log Log, data string) []LogLine {
+	pod, step := SplitLogName(core.StringNilMapper(log.Name))
+	lines := []LogLine{}
+	section := ""
+	for i, raw := range splitLines(data) {
+		raw = strings.TrimSuffix(raw, "\r")
+		if j := strings.LastIndex(raw, "\r"); j >= 0 {
+			raw = raw[j+1:]
+		}
+		line := LogLine{
+			Number: i + 1,
+			Stream: LogLineStreamStdoutConst,
+			Step:   step,
+			Pod:    pod,
+		}
+		if match := logTimestampPattern.FindStringSubmatch(raw); match != nil {
+			if parsed, err := time.Parse(time.RFC3339Nano, match[1]); err == nil {
+				line.Time = &parsed
+				if match[2] != "" {
+					line.Stream = match[2]
+				}
+				raw = raw[len(match[0]):]
+			}
+		}
+		line.Text, line.Segments = parseANSI(raw)
+
+		switch trimmed := strings.TrimSpace(line.Text); {
+		case strings.HasPrefix(trimmed, "::group::"):
+			section = strings.TrimSpace(strings.TrimPrefix(trimmed, "::group::"))
+			line.Section = section
+		case trimmed == "::endgroup::":
+			line.Section = section
+			section = ""
+		default:
+			line.Section = section
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// GetTektonPipelineRunLogLines fetches every step log of a pipeline run and parses it with ParseStepLog.
+func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunLogLines(ctx context.Context, pipelineID string, runID string) (lines []LogLine, err error) {
+	stepLogs, err := cdTektonPipeline.getStepLogs(ctx, pipelineID, runID)
+	if err != nil {
+		return
+	}
+	lines = []LogLine{}
+	for _, entry := range stepLogs {
+		lines = append(lines, ParseStepLog(entry.Log, entry.Data)...)
+	}
+	return
+}
+
+// RenderLogLinesJSON writes the lines to w as a JSON array.
+func RenderLogLinesJSON(w io.Writer, lines []LogLine) error {
+	if lines == nil {
+		lines = []LogLine{}
+	}
+	if err := json.NewEncoder(w).Encode(lines); err != nil {
+		return core.SDKErrorf(err, "", "json-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// RenderLogLinesHTML writes the lines to w as an HTML fragment: a `pre` element with one `div` per line, carrying the
+// step, pod, section and stream as data attributes, and the ANSI styles as `span` classes.
+func RenderLogLinesHTML(w io.Writer, lines []LogLine) error {
+	var out strings.Builder
+	out.WriteString(`<pre class="tekton-log">` + "\n")
+	for _, line := range lines {
+		fmt.Fprintf(&out, `<div class="log-line log-%s" data-step="%s" data-pod="%s" data-line="%d"`,
+			html.EscapeString(line.Stream), html.EscapeString(line.Step), html.EscapeString(line.Pod), line.Number)
+		if line.Section != "" {
+			fmt.Fprintf(&out, ` data-section="%s"`, html.EscapeString(line.Section))
+		}
+		out.WriteString(">")
+		if line.Time != nil {
+			fmt.Fprintf(&out, `<time class="log-time" datetime="%s">%s</time> `,
+				line.Time.Format(time.RFC3339Nano), line.Time.Format("15:04:05"))
+		}
+		if len(line.Segments) == 0 {
+			out.WriteString(html.EscapeString(line.Text))
+		}
+		for _, segment := range line.Segments {
+			if len(segment.Classes) == 0 {
+				out.WriteString(html.EscapeString(segment.Text))
+				continue
+			}
+			fmt.Fprintf(&out, `<span class="%s">%s</span>`, strings.Join(segment.Classes, " "), html.EscapeString(segment.Text))
+		}
+		out.WriteString("</div>\n")
+	}
+	out.WriteString("</pre>\n")
+	if _, err := io.WriteString(w, out.String()); err != nil {
+		return core.SDKErrorf(err, "", "html-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ansiStyle : The SGR state of a terminal.
+type ansiStyle struct {
+	bold, faint, italic, underline bool
+	foreground, background         string
+}
+
+func (style ansiStyle) classes() (classes []string) {
+	if style.bold {
+		classes = append(classes, "ansi-bold")
+	}
+	if style.faint {
+		classes = append(classes, "ansi-faint")
+	}
+	if style.italic {
+		classes = append(classes, "ansi-italic")
+	}
+	if style.underline {
+		classes = append(classes, "ansi-underline")
+	}
+	if style.foreground != "" {
+		classes = append(classes, "ansi-fg-"+style.foreground)
+	}
+	if style.background != "" {
+		classes = append(classes, "ansi-bg-"+style.background)
+	}
+	return
+}
+
+// apply updates the style with the parameters of an SGR sequence.
+func (style *ansiStyle) apply(parameters string) {
+	if parameters == "" {
+		parameters = "0"
+	}
+	codes := strings.Split(parameters, ";")
+	for i := 0; i < len(codes); i++ {
+		code, err := strconv.Atoi(codes[i])
+		if err != nil {
+			continue
+		}
+		switch {
+		case code == 0:
+			*style = ansiStyle{}
+		case code == 1:
+			style.bold = true
+		case code == 2:
+			style.faint = true
+		case code == 3:
+			style.italic = true
+		case code == 4:
+			style.underline = true
+		case code == 22:
+			style.bold, style.faint = false, false
+		case code == 23:
+			style.italic = false
+		case code == 24:
+			style.underline = false
+		case code >= 30 && code <= 37:
+			style.foreground = ansiColorNames[code-30]
+		case code >= 90 && code <= 97:
+			style.foreground = "bright-" + ansiColorNames[code-90]
+		case code == 39:
+			style.foreground = ""
+		case code >= 40 && code <= 47:
+			style.background = ansiColorNames[code-40]
+		case code >= 100 && code <= 107:
+			style.background = "bright-" + ansiColorNames[code-100]
+		case code == 49:
+			style.background = ""
+		case code == 38 || code == 48:
+			// Skip 256-colour and true-colour parameters, which have no class.
+			if i+1 < len(codes) && codes[i+1] == "5" {
+				i += 2
+			} else if i+1 < len(codes) && codes[i+1] == "2" {
+				i += 4
+			}
+		}
+	}
+}
+
+// parseANSI removes the ANSI escapes from a line and returns its text, and its styled segments if any SGR sequence
+// changed the style.
+func parseANSI(raw string) (text string, segments []LogSegment) {
+	matches := ansiEscapePattern.FindAllStringSubmatchIndex(raw, -1)
+	if len(matches) == 0 {
+		return raw, nil
+	}
+	var builder strings.Builder
+	var style ansiStyle
+	styled := false
+	appendText := func(s string) {
+		if s == "" {
+			return
+		}
+		builder.WriteString(s)
+		classes := style.classes()
+		if len(segments) > 0 && strings.Join(segments[len(segments)-1].Classes, " ") == strings.Join(classes, " ") {
+			segments[len(segments)-1].Text += s
+			return
+		}
+		segments = append(segments, LogSegment{Text: s, Classes: classes})
+	}
+	position := 0
+	for _, match := range matches {
+		appendText(raw[position:match[0]])
+		position = match[1]
+		if match[4] >= 0 && raw[match[4]:match[5]] == "m" {
+			style.apply(raw[match[2]:match[3]])
+			styled = true
+		}
+	}
+	appendText(raw[position:])
+	text = builder.String()
+	if !styled {
+		segments = nil
+	}
+	return
+}
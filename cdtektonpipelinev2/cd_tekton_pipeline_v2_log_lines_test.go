@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Step log parsing`, func() {
+	log := cdtektonpipelinev2.Log{ID: core.StringPtr("log-1"), Name: core.StringPtr("run-1-build-pod/step-build")}
+	data := "2026-03-01T10:00:00.123Z \x1b[1;32mBuilding\x1b[0m app\n" +
+		"::group::Test\n" +
+		"2026-03-01T10:00:01Z stderr F \x1b[31mFAIL\x1b[0m <pkg>\n" +
+		"::endgroup::\n" +
+		"progress 10%\rprogress 100%\n" +
+		"plain \x1b[2Kline\n"
+
+	Describe(`ParseStepLog(log, data)`, func() {
+		It(`Parses timestamps, streams, ANSI styles and sections`, func() {
+			lines := cdtektonpipelinev2.ParseStepLog(log, data)
+			Expect(lines).To(HaveLen(6))
+
+			Expect(lines[0].Number).To(Equal(1))
+			Expect(*lines[0].Time).To(Equal(time.Date(2026, 3, 1, 10, 0, 0, 123000000, time.UTC)))
+			Expect(lines[0].Stream).To(Equal("stdout"))
+			Expect(lines[0].Text).To(Equal("Building app"))
+			Expect(lines[0].Segments).To(Equal([]cdtektonpipelinev2.LogSegment{
+				{Text: "Building", Classes: []string{"ansi-bold", "ansi-fg-green"}},
+				{Text: " app"},
+			}))
+			Expect(lines[0].Step).To(Equal("step-build"))
+			Expect(lines[0].Pod).To(Equal("run-1-build-pod"))
+			Expect(lines[0].Section).To(Equal(""))
+
+			Expect(lines[1].Section).To(Equal("Test"))
+			Expect(lines[2].Stream).To(Equal("stderr"))
+			Expect(lines[2].Text).To(Equal("FAIL <pkg>"))
+			Expect(lines[2].Section).To(Equal("Test"))
+			Expect(lines[3].Section).To(Equal("Test"))
+			Expect(lines[4].Text).To(Equal("progress 100%"))
+			Expect(lines[4].Section).To(Equal(""))
+			Expect(lines[4].Time).To(BeNil())
+			Expect(lines[5].Text).To(Equal("plain line"))
+			Expect(lines[5].Segments).To(BeNil())
+		})
+	})
+	Describe(`RenderLogLinesHTML(w, lines) and RenderLogLinesJSON(w, lines)`, func() {
+		It(`Renders escaped HTML with style classes`, func() {
+			var out bytes.Buffer
+			Expect(cdtektonpipelinev2.RenderLogLinesHTML(&out, cdtektonpipelinev2.ParseStepLog(log, data)[:3])).To(Succeed())
+			Expect(out.String()).To(Equal(`<pre class="tekton-log">` + "\n" +
+				`<div class="log-line log-stdout" data-step="step-build" data-pod="run-1-build-pod" data-line="1">` +
+				`<time class="log-time" datetime="2026-03-01T10:00:00.123Z">10:00:00</time> ` +
+				`<span class="ansi-bold ansi-fg-green">Building</span> app</div>` + "\n" +
+				`<div class="log-line log-stdout" data-step="step-build" data-pod="run-1-build-pod" data-line="2" data-section="Test">::group::Test</div>` + "\n" +
+				`<div class="log-line log-stderr" data-step="step-build" data-pod="run-1-build-pod" data-line="3" data-section="Test">` +
+				`<time class="log-time" datetime="2026-03-01T10:00:01Z">10:00:01</time> ` +
+				`<span class="ansi-fg-red">FAIL</span> &lt;pkg&gt;</div>` + "\n" +
+				"</pre>\n"))
+		})
+		It(`Renders JSON`, func() {
+			var out bytes.Buffer
+			Expect(cdtektonpipelinev2.RenderLogLinesJSON(&out, cdtektonpipelinev2.ParseStepLog(log, data))).To(Succeed())
+			var decoded []map[string]interface{}
+			Expect(json.Unmarshal(out.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded).To(HaveLen(6))
+			Expect(decoded[2]).To(HaveKeyWithValue("stream", "stderr"))
+			Expect(decoded[2]).To(HaveKeyWithValue("time", "2026-03-01T10:00:01Z"))
+			Expect(decoded[5]).ToNot(HaveKey("segments"))
+		})
+	})
+	Describe(`GetTektonPipelineRunLogLines(ctx, pipelineID, runID)`, func() {
+		It(`Parses every step log of the run`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				switch req.URL.EscapedPath() {
+				case "/tekton_pipelines/pipeline-1/pipeline_runs/run-1/logs":
+					fmt.Fprintf(res, "%s", `{"logs": [{"id": "log-1", "name": "pod/step-clone"}, {"id": "log-2", "name": "pod/step-build"}]}`)
+				case "/tekton_pipelines/pipeline-1/pipeline_runs/run-1/logs/log-1":
+					fmt.Fprintf(res, "%s", `{"id": "log-1", "data": "cloning\n"}`)
+				case "/tekton_pipelines/pipeline-1/pipeline_runs/run-1/logs/log-2":
+					fmt.Fprintf(res, "%s", `{"id": "log-2", "data": "building\ndone\n"}`)
+				default:
+					Fail("unexpected request: " + req.URL.String())
+				}
+			}))
+			defer testServer.Close()
+			cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			lines, err := cdTektonPipelineService.GetTektonPipelineRunLogLines(context.Background(), "pipeline-1", "run-1")
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(3))
+			Expect(lines[0].Step).To(Equal("step-clone"))
+			Expect(lines[2].Step).To(Equal("step-build"))
+			Expect(lines[2].Number).To(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,225 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultMatrixConcurrency is the number of concurrent runs used by DispatchMatrix when neither RunMatrix.MaxConcurrency
+// nor the trigger's MaxConcurrentRuns is set.
+const DefaultMatrixConcurrency = 4
+
+// RunMatrix : The property combinations dispatched by DispatchMatrix.
+type RunMatrix struct {
+	// The values of each matrix property. One run is started for every combination of values.
+	Axes map[string][]string
+
+	// Trigger properties passed unchanged to every run.
+	Properties map[string]interface{}
+
+	// The maximum number of runs in progress at the same time. The trigger's MaxConcurrentRuns, when set and lower,
+	// takes precedence.
+	MaxConcurrency int
+
+	// Options for waiting on each run.
+	WaitOptions *WaitOptions
+}
+
+// MatrixCell : A single combination of a run matrix and the run started for it.
+type MatrixCell struct {
+	// The matrix property values of this combination.
+	Values map[string]string `json:"values"`
+
+	// The run, in the last observed status.
+	Run *PipelineRun `json:"run,omitempty"`
+
+	// The error that prevented the run from being started or waited on, if any.
+	Error error `json:"-"`
+}
+
+// Passed returns true if the run of the cell succeeded.
+func (cell *MatrixCell) Passed() bool {
+	return cell.Error == nil && cell.Run != nil && cell.Run.Status != nil && *cell.Run.Status == PipelineRunStatusSucceededConst
+}
+
+// MatrixResult : The outcome of DispatchMatrix.
+type MatrixResult struct {
+	// The matrix property names, sorted.
+	Axes []string `json:"axes"`
+
+	// One cell per combination, in the order the combinations were dispatched.
+	Cells []MatrixCell `json:"cells"`
+
+	// True if every run succeeded.
+	Passed bool `json:"passed"`
+}
+
+// Cell returns the cell with the specified property values, or nil if there is none.
+func (result *MatrixResult) Cell(values map[string]string) *MatrixCell {
+	for i := range result.Cells {
+		cell := &result.Cells[i]
+		if len(cell.Values) != len(values) {
+			continue
+		}
+		match := true
+		for name, value := range values {
+			if cell.Values[name] != value {
+				match = false
+				break
+			}
+		}
+		if match {
+			return cell
+		}
+	}
+	return nil
+}
+
+// DispatchMatrix starts one run of a trigger for every combination of the matrix property values and waits for all of
+// them. At most MaxConcurrency runs, further limited by the trigger's MaxConcurrentRuns, are in progress at the same
+// time. Failed runs do not stop the dispatch; MatrixResult.Passed reports whether all of them succeeded. If ctx is done
+// while waiting for a free slot, the combinations not yet started are not dispatched.
+func (cdTektonPipeline *CdTektonPipelineV2) DispatchMatrix(ctx context.Context, pipelineID string, triggerName string, matrix *RunMatrix) (result *MatrixResult, err error) {
+	err = core.ValidateNotNil(matrix, "matrix cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	result = &MatrixResult{}
+	for name, values := range matrix.Axes {
+		if len(values) == 0 {
+			err = core.SDKErrorf(nil, fmt.Sprintf("matrix property %s has no values", name), "empty-matrix-axis", common.GetComponentInfo())
+			return
+		}
+		result.Axes = append(result.Axes, name)
+	}
+	if len(result.Axes) == 0 {
+		err = core.SDKErrorf(nil, "the matrix has no properties", "empty-matrix", common.GetComponentInfo())
+		return
+	}
+	sort.Strings(result.Axes)
+
+	concurrency, err := cdTektonPipeline.matrixConcurrency(ctx, pipelineID, triggerName, matrix.MaxConcurrency)
+	if err != nil {
+		return
+	}
+
+	result.Cells = expandMatrix(result.Axes, matrix.Axes)
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for i := range result.Cells {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			// Combinations whose run has not been started are reported with the context error.
+			for j := i; j < len(result.Cells); j++ {
+				result.Cells[j].Error = ctx.Err()
+			}
+			break
+		}
+		wg.Add(1)
+		go func(cell *MatrixCell) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			cell.Run, cell.Error = cdTektonPipeline.dispatchMatrixCell(ctx, pipelineID, triggerName, matrix, result.Axes, cell.Values)
+		}(&result.Cells[i])
+	}
+	wg.Wait()
+
+	result.Passed = true
+	for i := range result.Cells {
+		if !result.Cells[i].Passed() {
+			result.Passed = false
+		}
+	}
+	return
+}
+
+// matrixConcurrency returns the number of matrix runs that may be in progress at the same time.
+func (cdTektonPipeline *CdTektonPipelineV2) matrixConcurrency(ctx context.Context, pipelineID string, triggerName string, maxConcurrency int) (concurrency int, err error) {
+	trigger, err := cdTektonPipeline.findTrigger(ctx, pipelineID, triggerName)
+	if err != nil {
+		return
+	}
+
+	concurrency = maxConcurrency
+	if trigger.MaxConcurrentRuns != nil && *trigger.MaxConcurrentRuns > 0 && (concurrency <= 0 || int(*trigger.MaxConcurrentRuns) < concurrency) {
+		concurrency = int(*trigger.MaxConcurrentRuns)
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultMatrixConcurrency
+	}
+	return
+}
+
+// dispatchMatrixCell starts the run of a single matrix combination and waits for it.
+func (cdTektonPipeline *CdTektonPipelineV2) dispatchMatrixCell(ctx context.Context, pipelineID string, triggerName string, matrix *RunMatrix, axes []string, values map[string]string) (run *PipelineRun, err error) {
+	properties := map[string]interface{}{}
+	for name, value := range matrix.Properties {
+		properties[name] = value
+	}
+	description := make([]string, 0, len(axes))
+	for _, name := range axes {
+		properties[name] = values[name]
+		description = append(description, name+"="+values[name])
+	}
+
+	createOptions := cdTektonPipeline.NewCreateTektonPipelineRunOptions(pipelineID)
+	createOptions.SetTriggerName(triggerName)
+	createOptions.SetTriggerProperties(properties)
+	createOptions.SetDescription("matrix " + strings.Join(description, ", "))
+	run, _, err = cdTektonPipeline.CreateTektonPipelineRunWithContext(ctx, createOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "matrix-create-run-error")
+		return
+	}
+	if run.ID == nil {
+		err = core.SDKErrorf(nil, "the created pipeline run has no ID", "missing-run-id", common.GetComponentInfo())
+		return
+	}
+	return cdTektonPipeline.WaitForTektonPipelineRun(ctx, pipelineID, *run.ID, matrix.WaitOptions)
+}
+
+// expandMatrix returns one cell for every combination of the values of the axes, varying the last axis fastest.
+func expandMatrix(axes []string, values map[string][]string) (cells []MatrixCell) {
+	cells = []MatrixCell{{Values: map[string]string{}}}
+	for _, name := range axes {
+		var expanded []MatrixCell
+		for _, cell := range cells {
+			for _, value := range values[name] {
+				combination := make(map[string]string, len(cell.Values)+1)
+				for existing, existingValue := range cell.Values {
+					combination[existing] = existingValue
+				}
+				combination[name] = value
+				expanded = append(expanded, MatrixCell{Values: combination})
+			}
+		}
+		cells = expanded
+	}
+	return
+}
@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DispatchMatrix(ctx, pipelineID, triggerName, matrix)`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var inFlight, maxInFlight int
+	var created []map[string]interface{}
+	var onCreate func()
+	BeforeEach(func() {
+		inFlight, maxInFlight, created, onCreate = 0, 0, nil, nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.EscapedPath()
+			switch {
+			case req.Method == "GET" && path == "/tekton_pipelines/pipeline-1/triggers":
+				Expect(req.URL.Query().Get("name")).To(Equal("deploy"))
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"triggers": [{"type": "manual", "id": "trigger-1", "name": "deploy", "max_concurrent_runs": 2}]}`)
+			case req.Method == "POST" && path == "/tekton_pipelines/pipeline-1/pipeline_runs":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["trigger_name"]).To(Equal("deploy"))
+				properties := body["trigger_properties"].(map[string]interface{})
+				mutex.Lock()
+				created = append(created, body)
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mutex.Unlock()
+				if onCreate != nil {
+					onCreate()
+				}
+				time.Sleep(5 * time.Millisecond)
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "run-%s-%s", "status": "pending"}`, properties["region"], properties["cluster"])
+			case req.Method == "GET" && strings.HasPrefix(path, "/tekton_pipelines/pipeline-1/pipeline_runs/"):
+				runID := strings.TrimPrefix(path, "/tekton_pipelines/pipeline-1/pipeline_runs/")
+				status := "succeeded"
+				if runID == "run-eu-de-b" {
+					status = "failed"
+				}
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "status": "%s"}`, runID, status)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	It(`Runs every combination within the trigger's concurrency limit`, func() {
+		result, err := newService().DispatchMatrix(context.Background(), "pipeline-1", "deploy", &cdtektonpipelinev2.RunMatrix{
+			Axes: map[string][]string{
+				"region":  {"us-south", "eu-de"},
+				"cluster": {"a", "b"},
+			},
+			Properties:     map[string]interface{}{"version": "1.2.3"},
+			MaxConcurrency: 10,
+			WaitOptions:    &cdtektonpipelinev2.WaitOptions{PollInterval: time.Millisecond},
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Axes).To(Equal([]string{"cluster", "region"}))
+		Expect(result.Cells).To(HaveLen(4))
+		Expect(result.Cells[0].Values).To(Equal(map[string]string{"cluster": "a", "region": "us-south"}))
+		Expect(result.Passed).To(BeFalse())
+		failed := result.Cell(map[string]string{"region": "eu-de", "cluster": "b"})
+		Expect(failed).ToNot(BeNil())
+		Expect(failed.Passed()).To(BeFalse())
+		Expect(*failed.Run.Status).To(Equal("failed"))
+		Expect(result.Cell(map[string]string{"region": "eu-de", "cluster": "a"}).Passed()).To(BeTrue())
+		Expect(maxInFlight).To(BeNumerically("<=", 2))
+		Expect(created).To(HaveLen(4))
+		for _, body := range created {
+			Expect(body["trigger_properties"]).To(HaveKeyWithValue("version", "1.2.3"))
+			Expect(body["description"]).To(HavePrefix("matrix cluster="))
+		}
+	})
+	It(`Does not start further runs once the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		onCreate = cancel
+		result, err := newService().DispatchMatrix(ctx, "pipeline-1", "deploy", &cdtektonpipelinev2.RunMatrix{
+			Axes:           map[string][]string{"region": {"us-south", "eu-de", "eu-gb"}},
+			MaxConcurrency: 1,
+			WaitOptions:    &cdtektonpipelinev2.WaitOptions{PollInterval: time.Millisecond},
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Passed).To(BeFalse())
+		Expect(created).To(HaveLen(1))
+		Expect(result.Cells[1].Error).To(Equal(context.Canceled))
+		Expect(result.Cells[2].Error).To(Equal(context.Canceled))
+	})
+	It(`Rejects an empty matrix`, func() {
+		_, err := newService().DispatchMatrix(context.Background(), "pipeline-1", "deploy", &cdtektonpipelinev2.RunMatrix{})
+		Expect(err).ToNot(BeNil())
+		_, err = newService().DispatchMatrix(context.Background(), "pipeline-1", "deploy", &cdtektonpipelinev2.RunMatrix{
+			Axes: map[string][]string{"region": {}},
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
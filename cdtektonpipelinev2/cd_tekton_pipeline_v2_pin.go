@@ -0,0 +1,229 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefinitionSelector : Selects the definitions pinned by PinTektonPipelineDefinitions. Every definition that follows
+// a branch matches when a field is empty.
+type DefinitionSelector struct {
+	// Only pin definitions from one of these repository URLs.
+	URLs []string
+
+	// Only pin definitions that follow one of these branches.
+	Branches []string
+
+	// Only pin definitions with one of these paths.
+	Paths []string
+}
+
+// DefinitionPin : The change of a single definition made by PinTektonPipelineDefinitions.
+type DefinitionPin struct {
+	// The ID of the definition.
+	DefinitionID string `json:"definition_id"`
+
+	// The source before it was pinned.
+	Previous *DefinitionSource `json:"previous"`
+
+	// The source after it was pinned.
+	Pinned *DefinitionSource `json:"pinned"`
+}
+
+// DefinitionPinRecord : The changes made by PinTektonPipelineDefinitions, which UnpinTektonPipelineDefinitions
+// reverts. It can be stored as JSON between the two calls.
+type DefinitionPinRecord struct {
+	// The ID of the pipeline.
+	PipelineID string `json:"pipeline_id"`
+
+	// The tag the definitions were pinned to.
+	Tag string `json:"tag"`
+
+	// The pinned definitions.
+	Pins []DefinitionPin `json:"pins"`
+}
+
+// PinTektonPipelineDefinitions replaces the source of every definition of a pipeline that follows a branch and
+// matches the selector with the same source at the specified tag. If any replacement fails, the definitions that were
+// already pinned are restored and the error is returned. If some of them cannot be restored either, the error has the
+// `pin-rollback-error` discriminator and the returned record holds the definitions left pinned, so that
+// UnpinTektonPipelineDefinitions can be retried with it.
+func (cdTektonPipeline *CdTektonPipelineV2) PinTektonPipelineDefinitions(ctx context.Context, pipelineID string, selector *DefinitionSelector, tag string) (record *DefinitionPinRecord, err error) {
+	if tag == "" {
+		err = core.SDKErrorf(nil, "tag must be specified", "missing-tag", common.GetComponentInfo())
+		return
+	}
+	if selector == nil {
+		selector = &DefinitionSelector{}
+	}
+	definitions, _, err := cdTektonPipeline.ListTektonPipelineDefinitionsWithContext(ctx, cdTektonPipeline.NewListTektonPipelineDefinitionsOptions(pipelineID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-definitions-error")
+		return
+	}
+
+	record = &DefinitionPinRecord{PipelineID: pipelineID, Tag: tag, Pins: []DefinitionPin{}}
+	for _, definition := range definitions.Definitions {
+		if definition.ID == nil || !selector.matches(definition.Source) {
+			continue
+		}
+		pinned := copyDefinitionSource(definition.Source)
+		pinned.Properties.Branch = nil
+		pinned.Properties.Tag = core.StringPtr(tag)
+		if err = cdTektonPipeline.replaceDefinitionSource(ctx, pipelineID, *definition.ID, pinned); err != nil {
+			remaining, rollbackErr := cdTektonPipeline.restoreDefinitions(ctx, pipelineID, record.Pins)
+			if rollbackErr != nil {
+				err = core.SDKErrorf(err, fmt.Sprintf("pinning definition %s failed: %s; the rollback also failed: %s", *definition.ID, err.Error(), rollbackErr.Error()), "pin-rollback-error", common.GetComponentInfo())
+				record.Pins = remaining
+				return
+			}
+			record = nil
+			return
+		}
+		record.Pins = append(record.Pins, DefinitionPin{
+			DefinitionID: *definition.ID,
+			Previous:     copyDefinitionSource(definition.Source),
+			Pinned:       pinned,
+		})
+	}
+	return
+}
+
+// UnpinTektonPipelineDefinitions restores the sources that PinTektonPipelineDefinitions replaced. A definition whose
+// source was changed again since it was pinned is left alone and reported in the returned error; the other definitions
+// are still restored.
+func (cdTektonPipeline *CdTektonPipelineV2) UnpinTektonPipelineDefinitions(ctx context.Context, record *DefinitionPinRecord) error {
+	if err := core.ValidateNotNil(record, "record cannot be nil"); err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	definitions, _, err := cdTektonPipeline.ListTektonPipelineDefinitionsWithContext(ctx, cdTektonPipeline.NewListTektonPipelineDefinitionsOptions(record.PipelineID))
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "list-definitions-error")
+	}
+	current := map[string]*DefinitionSource{}
+	for _, definition := range definitions.Definitions {
+		if definition.ID != nil {
+			current[*definition.ID] = definition.Source
+		}
+	}
+
+	var restore []DefinitionPin
+	var problems []string
+	for _, pin := range record.Pins {
+		source, ok := current[pin.DefinitionID]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("definition %s no longer exists", pin.DefinitionID))
+		case source == nil || source.Properties == nil || core.StringNilMapper(source.Properties.Tag) != record.Tag:
+			problems = append(problems, fmt.Sprintf("definition %s is no longer pinned to %s", pin.DefinitionID, record.Tag))
+		default:
+			restore = append(restore, pin)
+		}
+	}
+	if _, err = cdTektonPipeline.restoreDefinitions(ctx, record.PipelineID, restore); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, strings.Join(problems, "; "), "unpin-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// restoreDefinitions replaces the source of each pinned definition with its previous source, continuing past
+// failures. It returns the pins that could not be restored.
+func (cdTektonPipeline *CdTektonPipelineV2) restoreDefinitions(ctx context.Context, pipelineID string, pins []DefinitionPin) (remaining []DefinitionPin, err error) {
+	var failed []string
+	for _, pin := range pins {
+		if replaceErr := cdTektonPipeline.replaceDefinitionSource(ctx, pipelineID, pin.DefinitionID, copyDefinitionSource(pin.Previous)); replaceErr != nil {
+			failed = append(failed, fmt.Sprintf("definition %s: %s", pin.DefinitionID, replaceErr.Error()))
+			remaining = append(remaining, pin)
+		}
+	}
+	if len(failed) > 0 {
+		err = core.SDKErrorf(nil, "could not restore "+strings.Join(failed, "; "), "restore-definitions-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func (cdTektonPipeline *CdTektonPipelineV2) replaceDefinitionSource(ctx context.Context, pipelineID string, definitionID string, source *DefinitionSource) error {
+	_, _, err := cdTektonPipeline.ReplaceTektonPipelineDefinitionWithContext(ctx, cdTektonPipeline.NewReplaceTektonPipelineDefinitionOptions(pipelineID, definitionID, source))
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "replace-definition-error")
+	}
+	return nil
+}
+
+// matches returns true if the selector selects a definition with the specified source.
+func (selector *DefinitionSelector) matches(source *DefinitionSource) bool {
+	if source == nil || source.Properties == nil || core.StringNilMapper(source.Properties.Branch) == "" {
+		return false
+	}
+	properties := source.Properties
+	return matchesAny(selector.URLs, core.StringNilMapper(properties.URL)) &&
+		matchesAny(selector.Branches, core.StringNilMapper(properties.Branch)) &&
+		matchesAny(selector.Paths, core.StringNilMapper(properties.Path))
+}
+
+// matchesAny returns true if values is empty or contains value.
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// copyDefinitionSource returns a copy of a source that shares no pointers with it.
+func copyDefinitionSource(source *DefinitionSource) *DefinitionSource {
+	if source == nil {
+		return nil
+	}
+	copied := &DefinitionSource{}
+	if source.Type != nil {
+		copied.Type = core.StringPtr(*source.Type)
+	}
+	if properties := source.Properties; properties != nil {
+		copied.Properties = &DefinitionSourceProperties{}
+		for _, field := range []struct{ from, to **string }{
+			{&properties.URL, &copied.Properties.URL},
+			{&properties.Branch, &copied.Properties.Branch},
+			{&properties.Tag, &copied.Properties.Tag},
+			{&properties.Path, &copied.Properties.Path},
+		} {
+			if *field.from != nil {
+				*field.to = core.StringPtr(**field.from)
+			}
+		}
+		if properties.Tool != nil {
+			copied.Properties.Tool = &Tool{}
+			if properties.Tool.ID != nil {
+				copied.Properties.Tool.ID = core.StringPtr(*properties.Tool.ID)
+			}
+		}
+	}
+	return copied
+}
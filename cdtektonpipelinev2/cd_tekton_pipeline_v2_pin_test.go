@@ -0,0 +1,165 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Definition pinning`, func() {
+	var testServer *httptest.Server
+	var sources map[string]map[string]interface{}
+	var order []string
+	var failReplace string
+	var failRestore string
+	BeforeEach(func() {
+		source := func(url string, branch string) map[string]interface{} {
+			return map[string]interface{}{"type": "git", "properties": map[string]interface{}{"url": url, "branch": branch, "path": ".tekton"}}
+		}
+		sources = map[string]map[string]interface{}{
+			"def-1": source("https://github.com/org/app", "main"),
+			"def-2": source("https://github.com/org/catalog", "main"),
+			"def-3": source("https://github.com/org/app", "dev"),
+		}
+		order = []string{"def-1", "def-2", "def-3"}
+		failReplace, failRestore = "", ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.EscapedPath()
+			switch {
+			case req.Method == "GET" && path == "/tekton_pipelines/pipeline-1/definitions":
+				definitions := []map[string]interface{}{}
+				for _, id := range order {
+					definitions = append(definitions, map[string]interface{}{"id": id, "source": sources[id]})
+				}
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"definitions": definitions})).To(Succeed())
+			case req.Method == "PUT" && strings.HasPrefix(path, "/tekton_pipelines/pipeline-1/definitions/"):
+				id := strings.TrimPrefix(path, "/tekton_pipelines/pipeline-1/definitions/")
+				var body map[string]map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				_, restoring := body["source"]["properties"].(map[string]interface{})["branch"]
+				if id == failReplace || id == failRestore && restoring {
+					res.WriteHeader(500)
+					return
+				}
+				sources[id] = body["source"]
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"id": id, "source": sources[id]})).To(Succeed())
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+	properties := func(id string) map[string]interface{} {
+		return sources[id]["properties"].(map[string]interface{})
+	}
+
+	Describe(`PinTektonPipelineDefinitions(ctx, pipelineID, selector, tag)`, func() {
+		It(`Pins the selected definitions to the tag and unpins them again`, func() {
+			service := newService()
+			selector := &cdtektonpipelinev2.DefinitionSelector{Branches: []string{"main"}}
+			record, err := service.PinTektonPipelineDefinitions(context.Background(), "pipeline-1", selector, "v1.2.0")
+			Expect(err).To(BeNil())
+			Expect(record.Pins).To(HaveLen(2))
+			Expect(record.Pins[0].DefinitionID).To(Equal("def-1"))
+			Expect(*record.Pins[0].Previous.Properties.Branch).To(Equal("main"))
+			Expect(record.Pins[0].Pinned.Properties.Branch).To(BeNil())
+			Expect(properties("def-1")).To(HaveKeyWithValue("tag", "v1.2.0"))
+			Expect(properties("def-1")).ToNot(HaveKey("branch"))
+			Expect(properties("def-2")).To(HaveKeyWithValue("tag", "v1.2.0"))
+			Expect(properties("def-3")).To(HaveKeyWithValue("branch", "dev"))
+
+			stored, err := json.Marshal(record)
+			Expect(err).To(BeNil())
+			restored := &cdtektonpipelinev2.DefinitionPinRecord{}
+			Expect(json.Unmarshal(stored, restored)).To(Succeed())
+			Expect(service.UnpinTektonPipelineDefinitions(context.Background(), restored)).To(Succeed())
+			Expect(properties("def-1")).To(HaveKeyWithValue("branch", "main"))
+			Expect(properties("def-1")).ToNot(HaveKey("tag"))
+			Expect(properties("def-2")).To(HaveKeyWithValue("branch", "main"))
+		})
+		It(`Restores the definitions already pinned when a replacement fails`, func() {
+			failReplace = "def-3"
+			record, err := newService().PinTektonPipelineDefinitions(context.Background(), "pipeline-1", &cdtektonpipelinev2.DefinitionSelector{URLs: []string{"https://github.com/org/app"}}, "v1.2.0")
+			Expect(err).ToNot(BeNil())
+			Expect(record).To(BeNil())
+			Expect(properties("def-1")).To(HaveKeyWithValue("branch", "main"))
+			Expect(properties("def-1")).ToNot(HaveKey("tag"))
+			Expect(properties("def-3")).To(HaveKeyWithValue("branch", "dev"))
+		})
+		It(`Returns the definitions left pinned when the rollback fails`, func() {
+			failReplace, failRestore = "def-3", "def-2"
+			service := newService()
+			record, err := service.PinTektonPipelineDefinitions(context.Background(), "pipeline-1", nil, "v1.2.0")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the rollback also failed"))
+			Expect(record.Pins).To(HaveLen(1))
+			Expect(record.Pins[0].DefinitionID).To(Equal("def-2"))
+			Expect(properties("def-1")).To(HaveKeyWithValue("branch", "main"))
+			Expect(properties("def-2")).To(HaveKeyWithValue("tag", "v1.2.0"))
+
+			failRestore = ""
+			Expect(service.UnpinTektonPipelineDefinitions(context.Background(), record)).To(Succeed())
+			Expect(properties("def-2")).To(HaveKeyWithValue("branch", "main"))
+		})
+		It(`Requires a tag`, func() {
+			_, err := newService().PinTektonPipelineDefinitions(context.Background(), "pipeline-1", nil, "")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`UnpinTektonPipelineDefinitions(ctx, record)`, func() {
+		It(`Leaves definitions that changed since they were pinned alone`, func() {
+			service := newService()
+			record, err := service.PinTektonPipelineDefinitions(context.Background(), "pipeline-1", nil, "v1.2.0")
+			Expect(err).To(BeNil())
+			Expect(record.Pins).To(HaveLen(3))
+			properties("def-2")["tag"] = "v2.0.0"
+
+			err = service.UnpinTektonPipelineDefinitions(context.Background(), record)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("def-2 is no longer pinned to v1.2.0"))
+			Expect(properties("def-1")).To(HaveKeyWithValue("branch", "main"))
+			Expect(properties("def-2")).To(HaveKeyWithValue("tag", "v2.0.0"))
+			Expect(properties("def-3")).To(HaveKeyWithValue("branch", "dev"))
+		})
+	})
+})
@@ -0,0 +1,268 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the PropertyDifference.Kind property.
+const (
+	PropertyDifferenceKindChangedConst = "changed"
+	PropertyDifferenceKindAddedConst   = "added"
+	PropertyDifferenceKindRemovedConst = "removed"
+)
+
+// PropertyDiffOptions : Options for DiffTektonPipelineProperties.
+type PropertyDiffOptions struct {
+	// Also compare the properties of triggers, matched by trigger name.
+	IncludeTriggers bool
+
+	// Patterns of the expected differences, matched with path.Match against the property name for pipeline
+	// properties, against `<trigger>/<name>` for trigger properties and against the trigger name for a trigger that is
+	// only in one pipeline, for example `region`, `*/api-url` or `experiment`.
+	Allowed []string
+}
+
+// PropertyDifference : A single difference between the properties of two pipelines.
+type PropertyDifference struct {
+	// The name of the trigger, for trigger properties.
+	Trigger string `json:"trigger,omitempty"`
+
+	// The name of the property. Empty when a whole trigger is only in one pipeline.
+	Name string `json:"name,omitempty"`
+
+	// The field that differs: `type`, `value`, `enum`, `locked` or `path`. Empty when the property or trigger is only
+	// in one pipeline.
+	Field string `json:"field,omitempty"`
+
+	// `changed`, `added` (only in pipeline B) or `removed` (only in pipeline A).
+	Kind string `json:"kind"`
+
+	// The value in pipeline A. Secure values are replaced with RedactedValue.
+	A string `json:"a,omitempty"`
+
+	// The value in pipeline B. Secure values are replaced with RedactedValue.
+	B string `json:"b,omitempty"`
+
+	// Whether the difference matches one of the allowed patterns.
+	Allowed bool `json:"allowed"`
+}
+
+// PropertyDiff : The differences between the properties of two pipelines.
+type PropertyDiff struct {
+	// The ID of pipeline A.
+	PipelineA string `json:"pipeline_a"`
+
+	// The ID of pipeline B.
+	PipelineB string `json:"pipeline_b"`
+
+	// The differences, sorted by trigger and property, pipeline properties first.
+	Differences []PropertyDifference `json:"differences"`
+}
+
+// DiffTektonPipelineProperties compares the properties of two pipelines, such as the dev and prod pipelines of an
+// application, field by field. The API returns secure values as hashes, so comparing them compares their hashes; a
+// difference is reported with both values replaced by RedactedValue, so that not even the hashes are shown.
+func (cdTektonPipeline *CdTektonPipelineV2) DiffTektonPipelineProperties(ctx context.Context, pipelineA string, pipelineB string, opts *PropertyDiffOptions) (diff *PropertyDiff, err error) {
+	if opts == nil {
+		opts = &PropertyDiffOptions{}
+	}
+	var sets [2]map[string][]Property
+	for i, pipelineID := range []string{pipelineA, pipelineB} {
+		if sets[i], err = cdTektonPipeline.propertySets(ctx, pipelineID, opts.IncludeTriggers); err != nil {
+			return
+		}
+	}
+
+	diff = &PropertyDiff{PipelineA: pipelineA, PipelineB: pipelineB, Differences: []PropertyDifference{}}
+	for _, trigger := range mergedKeys(sets[0], sets[1]) {
+		propertiesA, inA := sets[0][trigger]
+		propertiesB, inB := sets[1][trigger]
+		switch {
+		case !inB:
+			diff.add(opts, PropertyDifference{Trigger: trigger, Kind: PropertyDifferenceKindRemovedConst})
+		case !inA:
+			diff.add(opts, PropertyDifference{Trigger: trigger, Kind: PropertyDifferenceKindAddedConst})
+		default:
+			diffPropertySet(diff, opts, trigger, propertiesA, propertiesB)
+		}
+	}
+	return
+}
+
+// Unexpected returns the differences that do not match an allowed pattern.
+func (diff *PropertyDiff) Unexpected() (unexpected []PropertyDifference) {
+	for _, difference := range diff.Differences {
+		if !difference.Allowed {
+			unexpected = append(unexpected, difference)
+		}
+	}
+	return
+}
+
+// Table formats the differences as an aligned text table.
+func (diff *PropertyDiff) Table() string {
+	var table strings.Builder
+	writer := tabwriter.NewWriter(&table, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "TRIGGER\tPROPERTY\tFIELD\tKIND\t"+diff.PipelineA+"\t"+diff.PipelineB+"\tALLOWED")
+	for _, difference := range diff.Differences {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%t\n", tableCell(difference.Trigger), tableCell(difference.Name), tableCell(difference.Field),
+			difference.Kind, tableCell(difference.A), tableCell(difference.B), difference.Allowed)
+	}
+	writer.Flush()
+	return table.String()
+}
+
+// add appends a difference, marking whether it is allowed.
+func (diff *PropertyDiff) add(opts *PropertyDiffOptions, difference PropertyDifference) {
+	key := difference.Name
+	if difference.Trigger != "" {
+		key = difference.Trigger
+		if difference.Name != "" {
+			key += "/" + difference.Name
+		}
+	}
+	for _, pattern := range opts.Allowed {
+		if matched, _ := path.Match(pattern, key); matched || pattern == key {
+			difference.Allowed = true
+			break
+		}
+	}
+	diff.Differences = append(diff.Differences, difference)
+}
+
+// propertySets returns the properties of a pipeline under the empty key and, when requested, those of each trigger
+// under its name.
+func (cdTektonPipeline *CdTektonPipelineV2) propertySets(ctx context.Context, pipelineID string, includeTriggers bool) (sets map[string][]Property, err error) {
+	properties, err := cdTektonPipeline.listProperties(ctx, pipelineID)
+	if err != nil {
+		return
+	}
+	sets = map[string][]Property{"": properties}
+	if !includeTriggers {
+		return
+	}
+	triggers, _, err := cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, cdTektonPipeline.NewListTektonPipelineTriggersOptions(pipelineID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-triggers-error")
+		return
+	}
+	for _, triggerIntf := range triggers.Triggers {
+		trigger := AsTrigger(triggerIntf)
+		if trigger == nil || core.StringNilMapper(trigger.Name) == "" {
+			continue
+		}
+		converted := []Property{}
+		for _, property := range trigger.Properties {
+			converted = append(converted, Property{
+				Name:   property.Name,
+				Value:  property.Value,
+				Enum:   property.Enum,
+				Type:   property.Type,
+				Locked: property.Locked,
+				Path:   property.Path,
+			})
+		}
+		sets[*trigger.Name] = converted
+	}
+	return
+}
+
+// diffPropertySet adds the differences between two sets of properties of the same trigger, or of the pipelines.
+func diffPropertySet(diff *PropertyDiff, opts *PropertyDiffOptions, trigger string, propertiesA []Property, propertiesB []Property) {
+	fieldsA, fieldsB := map[string]map[string]string{}, map[string]map[string]string{}
+	for _, property := range propertiesA {
+		fieldsA[core.StringNilMapper(property.Name)] = propertyFields(property)
+	}
+	for _, property := range propertiesB {
+		fieldsB[core.StringNilMapper(property.Name)] = propertyFields(property)
+	}
+	for _, name := range mergedKeys(fieldsA, fieldsB) {
+		a, inA := fieldsA[name]
+		b, inB := fieldsB[name]
+		switch {
+		case !inB:
+			diff.add(opts, PropertyDifference{Trigger: trigger, Name: name, Kind: PropertyDifferenceKindRemovedConst, A: reportedField(a, "value")})
+		case !inA:
+			diff.add(opts, PropertyDifference{Trigger: trigger, Name: name, Kind: PropertyDifferenceKindAddedConst, B: reportedField(b, "value")})
+		default:
+			for _, field := range []string{"type", "value", "enum", "locked", "path"} {
+				if a[field] != b[field] {
+					diff.add(opts, PropertyDifference{Trigger: trigger, Name: name, Field: field, Kind: PropertyDifferenceKindChangedConst, A: reportedField(a, field), B: reportedField(b, field)})
+				}
+			}
+		}
+	}
+}
+
+// propertyFields returns the compared fields of a property. The options of a single_select property are encoded as a
+// JSON array, so that an option containing a comma is not mistaken for two options.
+func propertyFields(property Property) map[string]string {
+	enum := ""
+	if len(property.Enum) > 0 {
+		data, _ := json.Marshal(property.Enum)
+		enum = string(data)
+	}
+	return map[string]string{
+		"type":   core.StringNilMapper(property.Type),
+		"value":  core.StringNilMapper(property.Value),
+		"enum":   enum,
+		"locked": strconv.FormatBool(property.Locked != nil && *property.Locked),
+		"path":   core.StringNilMapper(property.Path),
+	}
+}
+
+// reportedField returns a field of a property as it is reported, with a secure value replaced by RedactedValue.
+func reportedField(fields map[string]string, field string) string {
+	if field == "value" && fields["type"] == PropertyTypeSecureConst && fields["value"] != "" {
+		return RedactedValue
+	}
+	return fields[field]
+}
+
+// mergedKeys returns the sorted keys present in either map.
+func mergedKeys[V any](a map[string]V, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// tableCell returns a placeholder for empty table cells.
+func tableCell(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Property diff`, func() {
+	var testServer *httptest.Server
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/tekton_pipelines/dev/properties":
+				fmt.Fprintf(res, "%s", `{"properties": [
+					{"name": "region", "type": "single_select", "value": "us-south", "enum": ["us-south", "eu-de"]},
+					{"name": "api-key", "type": "secure", "value": "dev-secret"},
+					{"name": "token", "type": "secure", "value": "same-secret"},
+					{"name": "debug", "type": "text", "value": "true"}]}`)
+			case "/tekton_pipelines/prod/properties":
+				fmt.Fprintf(res, "%s", `{"properties": [
+					{"name": "region", "type": "single_select", "value": "eu-de", "enum": ["us-south", "eu-de"], "locked": true},
+					{"name": "api-key", "type": "secure", "value": "prod-secret"},
+					{"name": "token", "type": "secure", "value": "same-secret"},
+					{"name": "replicas", "type": "text", "value": "3"}]}`)
+			case "/tekton_pipelines/dev/triggers":
+				fmt.Fprintf(res, "%s", `{"triggers": [
+					{"id": "t-1", "name": "nightly", "type": "manual", "event_listener": "listener", "properties": [{"name": "url", "type": "text", "value": "https://dev"},
+						{"name": "size", "type": "single_select", "value": "s", "enum": ["s,m"]}]},
+					{"id": "t-2", "name": "experiment", "type": "manual", "event_listener": "listener"}]}`)
+			case "/tekton_pipelines/prod/triggers":
+				fmt.Fprintf(res, "%s", `{"triggers": [
+					{"id": "t-3", "name": "nightly", "type": "manual", "event_listener": "listener", "properties": [{"name": "url", "type": "text", "value": "https://prod"},
+						{"name": "size", "type": "single_select", "value": "s", "enum": ["s", "m"]}]}]}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	Describe(`DiffTektonPipelineProperties(ctx, pipelineA, pipelineB, opts)`, func() {
+		It(`Compares pipeline properties field by field, redacting secure values`, func() {
+			diff, err := newService().DiffTektonPipelineProperties(context.Background(), "dev", "prod", &cdtektonpipelinev2.PropertyDiffOptions{Allowed: []string{"region", "api-*"}})
+			Expect(err).To(BeNil())
+			Expect(diff.Differences).To(HaveLen(5))
+			Expect(diff.Differences[0].Name).To(Equal("api-key"))
+			Expect(diff.Differences[0].Field).To(Equal("value"))
+			Expect(diff.Differences[0].A).To(Equal(cdtektonpipelinev2.RedactedValue))
+			Expect(diff.Differences[0].B).To(Equal(cdtektonpipelinev2.RedactedValue))
+			Expect(diff.Differences[0].Allowed).To(BeTrue())
+			Expect(diff.Differences[1:]).To(Equal([]cdtektonpipelinev2.PropertyDifference{
+				{Name: "debug", Kind: cdtektonpipelinev2.PropertyDifferenceKindRemovedConst, A: "true"},
+				{Name: "region", Field: "value", Kind: cdtektonpipelinev2.PropertyDifferenceKindChangedConst, A: "us-south", B: "eu-de", Allowed: true},
+				{Name: "region", Field: "locked", Kind: cdtektonpipelinev2.PropertyDifferenceKindChangedConst, A: "false", B: "true", Allowed: true},
+				{Name: "replicas", Kind: cdtektonpipelinev2.PropertyDifferenceKindAddedConst, B: "3"},
+			}))
+			Expect(diff.Unexpected()).To(HaveLen(2))
+
+			table := diff.Table()
+			Expect(table).To(HavePrefix("TRIGGER  PROPERTY  FIELD   KIND     dev"))
+			Expect(table).To(ContainSubstring("-        region    locked  changed  false"))
+			Expect(table).ToNot(ContainSubstring("secret"))
+		})
+		It(`Compares trigger properties by trigger name`, func() {
+			diff, err := newService().DiffTektonPipelineProperties(context.Background(), "dev", "prod", &cdtektonpipelinev2.PropertyDiffOptions{
+				IncludeTriggers: true,
+				Allowed:         []string{"*/url", "api-key", "debug", "region", "replicas"},
+			})
+			Expect(err).To(BeNil())
+			Expect(diff.Unexpected()).To(Equal([]cdtektonpipelinev2.PropertyDifference{
+				{Trigger: "experiment", Kind: cdtektonpipelinev2.PropertyDifferenceKindRemovedConst},
+				{Trigger: "nightly", Name: "size", Field: "enum", Kind: cdtektonpipelinev2.PropertyDifferenceKindChangedConst, A: `["s,m"]`, B: `["s","m"]`},
+			}))
+
+			diff, err = newService().DiffTektonPipelineProperties(context.Background(), "dev", "prod", &cdtektonpipelinev2.PropertyDiffOptions{
+				IncludeTriggers: true,
+				Allowed:         []string{"*/*", "api-key", "debug", "region", "replicas", "exp*"},
+			})
+			Expect(err).To(BeNil())
+			Expect(diff.Unexpected()).To(BeEmpty())
+			var nightly []string
+			for _, difference := range diff.Differences {
+				if difference.Trigger == "nightly" {
+					nightly = append(nightly, strings.Join([]string{difference.Name, difference.Field, difference.A, difference.B}, " "))
+				}
+			}
+			Expect(nightly).To(Equal([]string{`size enum ["s,m"] ["s","m"]`, "url value https://dev https://prod"}))
+		})
+	})
+})
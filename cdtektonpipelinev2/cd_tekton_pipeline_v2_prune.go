@@ -0,0 +1,272 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultPruneConcurrency is the number of concurrent deletions used when PrunePolicy.Concurrency is not set.
+const DefaultPruneConcurrency = 4
+
+// PrunePolicy : Describes which pipeline runs are deleted by PruneTektonPipelineRuns. At least one of
+// KeepLastPerTrigger and DeleteOlderThan must be set. When both are set, a run is deleted only if it is older than
+// DeleteOlderThan and not among the KeepLastPerTrigger most recent runs of its trigger.
+type PrunePolicy struct {
+	// Keep the specified number of most recent runs of each trigger.
+	KeepLastPerTrigger int
+
+	// Delete runs created longer ago than this.
+	DeleteOlderThan time.Duration
+
+	// Keep runs with status `failed` or `error` that were created within this period.
+	KeepFailuresFor time.Duration
+
+	// Never delete runs whose description contains any of these tags.
+	KeepDescriptionTags []string
+
+	// The maximum number of concurrent deletions.
+	Concurrency int
+
+	// Report what would be deleted without deleting anything.
+	DryRun bool
+
+	// The current time used to evaluate ages. The default is the time PruneTektonPipelineRuns is called.
+	Now time.Time
+}
+
+// PruneEntry : A pipeline run considered by PruneTektonPipelineRuns.
+type PruneEntry struct {
+	// The ID of the run.
+	RunID string `json:"run_id"`
+
+	// The name of the trigger that started the run.
+	TriggerName string `json:"trigger_name"`
+
+	// The status of the run.
+	Status string `json:"status"`
+
+	// When the run was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Why the run was kept or deleted.
+	Reason string `json:"reason"`
+
+	// The error returned by DeleteTektonPipelineRun, for runs that could not be deleted.
+	Error error `json:"-"`
+}
+
+// PruneReport : The outcome of PruneTektonPipelineRuns.
+type PruneReport struct {
+	// True if nothing was deleted because the policy was a dry run.
+	DryRun bool `json:"dry_run"`
+
+	// Runs that were deleted, or would be deleted in a dry run.
+	Deleted []PruneEntry `json:"deleted"`
+
+	// Terminal runs that were kept.
+	Kept []PruneEntry `json:"kept"`
+
+	// Runs that were skipped because they have not finished or their creation time is unknown.
+	Skipped []PruneEntry `json:"skipped"`
+
+	// Runs whose deletion failed.
+	Failed []PruneEntry `json:"failed"`
+}
+
+// PruneTektonPipelineRuns walks every run of a pipeline with the runs pager and deletes the finished runs that the
+// policy does not keep, with bounded concurrency. Runs that have not finished, and runs without a creation time, are
+// never deleted. If any deletion fails, or ctx is done before every deletion has started, the report is returned
+// together with an error.
+func (cdTektonPipeline *CdTektonPipelineV2) PruneTektonPipelineRuns(ctx context.Context, pipelineID string, policy *PrunePolicy) (report *PruneReport, err error) {
+	err = core.ValidateNotNil(policy, "policy cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if policy.KeepLastPerTrigger <= 0 && policy.DeleteOlderThan <= 0 {
+		err = core.SDKErrorf(nil, "the policy must set KeepLastPerTrigger or DeleteOlderThan", "invalid-prune-policy", common.GetComponentInfo())
+		return
+	}
+
+	pager, err := cdTektonPipeline.NewTektonPipelineRunsPager(cdTektonPipeline.NewListTektonPipelineRunsOptions(pipelineID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "prune-pager-error")
+		return
+	}
+	runs, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "prune-list-error")
+		return
+	}
+
+	report = &PruneReport{DryRun: policy.DryRun}
+	var toDelete []PruneEntry
+	for _, entry := range policy.evaluate(runs) {
+		switch {
+		case entry.Reason == pruneReasonNotFinished || entry.Reason == pruneReasonNoCreatedAt:
+			report.Skipped = append(report.Skipped, entry)
+		case strings.HasPrefix(entry.Reason, "delete"):
+			toDelete = append(toDelete, entry)
+		default:
+			report.Kept = append(report.Kept, entry)
+		}
+	}
+	if policy.DryRun {
+		report.Deleted = toDelete
+		return
+	}
+
+	concurrency := policy.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultPruneConcurrency
+	}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for i, entry := range toDelete {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			// Runs whose deletion has not started are reported as failed with the context error.
+			mutex.Lock()
+			for _, entry := range toDelete[i:] {
+				entry.Error = ctx.Err()
+				report.Failed = append(report.Failed, entry)
+			}
+			mutex.Unlock()
+			break
+		}
+		wg.Add(1)
+		go func(entry PruneEntry) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			_, deleteErr := cdTektonPipeline.DeleteTektonPipelineRunWithContext(ctx, cdTektonPipeline.NewDeleteTektonPipelineRunOptions(pipelineID, entry.RunID))
+			mutex.Lock()
+			defer mutex.Unlock()
+			if deleteErr != nil {
+				entry.Error = deleteErr
+				report.Failed = append(report.Failed, entry)
+			} else {
+				report.Deleted = append(report.Deleted, entry)
+			}
+		}(entry)
+	}
+	wg.Wait()
+	sortPruneEntries(report.Deleted)
+	sortPruneEntries(report.Failed)
+
+	if len(report.Failed) > 0 {
+		err = core.SDKErrorf(report.Failed[0].Error, fmt.Sprintf("%d of %d pipeline runs could not be deleted", len(report.Failed), len(toDelete)), "prune-delete-error", common.GetComponentInfo())
+	}
+	return
+}
+
+const (
+	pruneReasonNotFinished = "not finished"
+	pruneReasonNoCreatedAt = "skip: creation time unknown"
+	pruneReasonTagged      = "keep: tagged in description"
+	pruneReasonFailure     = "keep: recent failure"
+	pruneReasonRecent      = "keep: among the most recent runs of its trigger"
+	pruneReasonYoung       = "keep: not older than the retention period"
+	pruneReasonBeyondLast  = "delete: beyond the most recent runs of its trigger"
+	pruneReasonOld         = "delete: older than the retention period"
+)
+
+// evaluate decides, for each run, whether the policy keeps or deletes it.
+func (policy *PrunePolicy) evaluate(runs []PipelineRun) (entries []PruneEntry) {
+	now := policy.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	for _, run := range runs {
+		entry := PruneEntry{
+			RunID:  core.StringNilMapper(run.ID),
+			Status: core.StringNilMapper(run.Status),
+		}
+		if trigger := AsTrigger(run.Trigger); trigger != nil {
+			entry.TriggerName = core.StringNilMapper(trigger.Name)
+		}
+		if entry.RunID == "" {
+			continue
+		}
+		entries = append(entries, entry)
+		if !IsTerminalPipelineRunStatus(entry.Status) {
+			entries[len(entries)-1].Reason = pruneReasonNotFinished
+			continue
+		}
+		if run.CreatedAt == nil {
+			// Without a creation time the age of the run and its position among the runs of its trigger are unknown.
+			entries[len(entries)-1].Reason = pruneReasonNoCreatedAt
+			continue
+		}
+		entries[len(entries)-1].CreatedAt = time.Time(*run.CreatedAt)
+		if run.Description != nil {
+			for _, tag := range policy.KeepDescriptionTags {
+				if tag != "" && strings.Contains(*run.Description, tag) {
+					entries[len(entries)-1].Reason = pruneReasonTagged
+					break
+				}
+			}
+		}
+	}
+	sortPruneEntries(entries)
+
+	seenPerTrigger := map[string]int{}
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Reason == pruneReasonNotFinished || entry.Reason == pruneReasonNoCreatedAt {
+			continue
+		}
+		seenPerTrigger[entry.TriggerName]++
+		if entry.Reason != "" {
+			continue
+		}
+		age := now.Sub(entry.CreatedAt)
+		failed := entry.Status == PipelineRunStatusFailedConst || entry.Status == PipelineRunStatusErrorConst
+		switch {
+		case failed && policy.KeepFailuresFor > 0 && age <= policy.KeepFailuresFor:
+			entry.Reason = pruneReasonFailure
+		case policy.KeepLastPerTrigger > 0 && seenPerTrigger[entry.TriggerName] <= policy.KeepLastPerTrigger:
+			entry.Reason = pruneReasonRecent
+		case policy.DeleteOlderThan > 0 && age <= policy.DeleteOlderThan:
+			entry.Reason = pruneReasonYoung
+		case policy.DeleteOlderThan > 0:
+			entry.Reason = pruneReasonOld
+		default:
+			entry.Reason = pruneReasonBeyondLast
+		}
+	}
+	return
+}
+
+// sortPruneEntries sorts entries from the most recent to the oldest.
+func sortPruneEntries(entries []PruneEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+}
@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PruneTektonPipelineRuns(ctx, pipelineID, policy)`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var deleted []string
+	var onDelete func()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	BeforeEach(func() {
+		deleted = nil
+		onDelete = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"limit": 50, "first": {"href": "https://cloud.ibm.com"}, "pipeline_runs": [
+					{"id": "run-7", "status": "running", "created_at": "2026-03-10T11:00:00.000Z", "trigger": {"type": "manual", "name": "ci"}},
+					{"id": "run-6", "status": "succeeded", "created_at": "2026-03-09T00:00:00.000Z", "trigger": {"type": "manual", "name": "ci"}},
+					{"id": "run-5", "status": "succeeded", "created_at": "2026-03-08T00:00:00.000Z", "trigger": {"type": "manual", "name": "deploy"}},
+					{"id": "run-4", "status": "failed", "created_at": "2026-03-07T00:00:00.000Z", "trigger": {"type": "manual", "name": "ci"}},
+					{"id": "run-3", "status": "succeeded", "created_at": "2026-03-01T00:00:00.000Z", "trigger": {"type": "manual", "name": "ci"}},
+					{"id": "run-2", "status": "succeeded", "created_at": "2026-02-01T00:00:00.000Z", "trigger": {"type": "manual", "name": "ci"}, "description": "release [keep]"},
+					{"id": "run-1", "status": "error", "created_at": "2026-01-01T00:00:00.000Z", "trigger": {"type": "manual", "name": "deploy"}},
+					{"id": "run-0", "status": "succeeded", "trigger": {"type": "manual", "name": "ci"}}
+				]}`)
+			case "DELETE /tekton_pipelines/pipeline-1/pipeline_runs/run-1":
+				res.WriteHeader(500)
+				fmt.Fprintf(res, "%s", `{"errors": [{"message": "internal error"}]}`)
+			default:
+				Expect(req.Method).To(Equal("DELETE"))
+				mutex.Lock()
+				deleted = append(deleted, req.URL.EscapedPath())
+				mutex.Unlock()
+				if onDelete != nil {
+					onDelete()
+				}
+				res.WriteHeader(204)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+	runIDs := func(entries []cdtektonpipelinev2.PruneEntry) (ids []string) {
+		for _, entry := range entries {
+			ids = append(ids, entry.RunID)
+		}
+		return
+	}
+
+	It(`Reports the runs a dry run would delete`, func() {
+		report, err := newService().PruneTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.PrunePolicy{
+			KeepLastPerTrigger:  1,
+			KeepFailuresFor:     7 * 24 * time.Hour,
+			KeepDescriptionTags: []string{"[keep]"},
+			DryRun:              true,
+			Now:                 now,
+		})
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(runIDs(report.Deleted)).To(Equal([]string{"run-3", "run-1"}))
+		Expect(runIDs(report.Kept)).To(Equal([]string{"run-6", "run-5", "run-4", "run-2"}))
+		Expect(runIDs(report.Skipped)).To(Equal([]string{"run-7", "run-0"}))
+		Expect(report.Skipped[1].Reason).To(Equal("skip: creation time unknown"))
+		Expect(report.Kept[2].Reason).To(Equal("keep: recent failure"))
+		Expect(report.Kept[3].Reason).To(Equal("keep: tagged in description"))
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Deletes runs older than the retention period and reports failures`, func() {
+		report, err := newService().PruneTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.PrunePolicy{
+			DeleteOlderThan: 5 * 24 * time.Hour,
+			Concurrency:     2,
+			Now:             now,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("1 of 3 pipeline runs could not be deleted"))
+		Expect(runIDs(report.Deleted)).To(Equal([]string{"run-3", "run-2"}))
+		Expect(runIDs(report.Failed)).To(Equal([]string{"run-1"}))
+		Expect(report.Failed[0].Error).ToNot(BeNil())
+		sort.Strings(deleted)
+		Expect(deleted).To(Equal([]string{
+			"/tekton_pipelines/pipeline-1/pipeline_runs/run-2",
+			"/tekton_pipelines/pipeline-1/pipeline_runs/run-3",
+		}))
+	})
+	It(`Stops starting deletions when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		onDelete = cancel
+		report, err := newService().PruneTektonPipelineRuns(ctx, "pipeline-1", &cdtektonpipelinev2.PrunePolicy{
+			DeleteOlderThan: 5 * 24 * time.Hour,
+			Concurrency:     1,
+			Now:             now,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(runIDs(report.Failed)).To(ContainElements("run-2", "run-1"))
+		for _, entry := range report.Failed {
+			if entry.RunID != "run-3" {
+				Expect(entry.Error).To(Equal(context.Canceled))
+			}
+		}
+		Expect(deleted).To(Equal([]string{"/tekton_pipelines/pipeline-1/pipeline_runs/run-3"}))
+	})
+	It(`Combines the count and age rules`, func() {
+		report, err := newService().PruneTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.PrunePolicy{
+			KeepLastPerTrigger: 2,
+			DeleteOlderThan:    5 * 24 * time.Hour,
+			DryRun:             true,
+			Now:                now,
+		})
+		Expect(err).To(BeNil())
+		Expect(runIDs(report.Deleted)).To(Equal([]string{"run-3", "run-2"}))
+	})
+	It(`Rejects an invalid policy`, func() {
+		_, err := newService().PruneTektonPipelineRuns(context.Background(), "pipeline-1", nil)
+		Expect(err).ToNot(BeNil())
+		_, err = newService().PruneTektonPipelineRuns(context.Background(), "pipeline-1", &cdtektonpipelinev2.PrunePolicy{DryRun: true})
+		Expect(err).ToNot(BeNil())
+	})
+})
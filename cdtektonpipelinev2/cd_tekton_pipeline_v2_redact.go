@@ -0,0 +1,441 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RedactedValue replaces secure values in redacted output.
+const RedactedValue = "[REDACTED]"
+
+// MinRedactedLength is the length below which values are not redacted, since masking very short values would mask
+// unrelated text.
+const MinRedactedLength = 4
+
+// Redactor : Masks secure values, and their base64 and URL-encoded forms, in text.
+type Redactor struct {
+	mutex    sync.RWMutex
+	values   map[string]bool
+	replacer *strings.Replacer
+}
+
+// NewRedactor returns a redactor for the specified secure values.
+func NewRedactor(values ...string) *Redactor {
+	redactor := &Redactor{values: map[string]bool{}}
+	redactor.AddValues(values...)
+	return redactor
+}
+
+// NewPipelineRedactor returns a redactor for the secure properties of a pipeline and of its triggers, and for the
+// secure values passed to a run, such as CreateTektonPipelineRunOptions.SecureTriggerProperties.
+func NewPipelineRedactor(pipeline *TektonPipeline, secureOverrides map[string]interface{}) *Redactor {
+	redactor := NewRedactor()
+	if pipeline != nil {
+		redactor.AddProperties(pipeline.Properties)
+		for _, triggerIntf := range pipeline.Triggers {
+			if trigger := AsTrigger(triggerIntf); trigger != nil {
+				redactor.AddTriggerProperties(trigger.Properties)
+			}
+		}
+	}
+	redactor.AddSecureProperties(secureOverrides)
+	return redactor
+}
+
+// AddValues adds secure values to the redactor.
+func (redactor *Redactor) AddValues(values ...string) {
+	redactor.mutex.Lock()
+	defer redactor.mutex.Unlock()
+	for _, value := range values {
+		if len(value) < MinRedactedLength {
+			continue
+		}
+		for _, form := range []string{
+			value,
+			base64.StdEncoding.EncodeToString([]byte(value)),
+			base64.RawStdEncoding.EncodeToString([]byte(value)),
+			base64.URLEncoding.EncodeToString([]byte(value)),
+			base64.RawURLEncoding.EncodeToString([]byte(value)),
+			url.QueryEscape(value),
+			url.PathEscape(value),
+		} {
+			redactor.values[form] = true
+		}
+	}
+	redactor.replacer = nil
+}
+
+// AddProperties adds the values of the secure properties to the redactor.
+func (redactor *Redactor) AddProperties(properties []Property) {
+	for _, property := range properties {
+		if core.StringNilMapper(property.Type) == PropertyTypeSecureConst {
+			redactor.AddValues(core.StringNilMapper(property.Value))
+		}
+	}
+}
+
+// AddTriggerProperties adds the values of the secure trigger properties to the redactor.
+func (redactor *Redactor) AddTriggerProperties(properties []TriggerProperty) {
+	for _, property := range properties {
+		if core.StringNilMapper(property.Type) == TriggerPropertyTypeSecureConst {
+			redactor.AddValues(core.StringNilMapper(property.Value))
+		}
+	}
+}
+
+// AddSecureProperties adds the string values of a secure properties map, such as
+// PipelineRunTrigger.SecureProperties, to the redactor.
+func (redactor *Redactor) AddSecureProperties(properties map[string]interface{}) {
+	for _, value := range properties {
+		if s, ok := value.(string); ok {
+			redactor.AddValues(s)
+		}
+	}
+}
+
+// Redact returns text with every secure value replaced by RedactedValue.
+func (redactor *Redactor) Redact(text string) string {
+	return redactor.getReplacer().Replace(text)
+}
+
+// getReplacer returns the replacer for the current values, matching longer values first.
+func (redactor *Redactor) getReplacer() *strings.Replacer {
+	redactor.mutex.RLock()
+	replacer := redactor.replacer
+	redactor.mutex.RUnlock()
+	if replacer != nil {
+		return replacer
+	}
+
+	redactor.mutex.Lock()
+	defer redactor.mutex.Unlock()
+	values := make([]string, 0, len(redactor.values))
+	for value := range redactor.values {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	pairs := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		pairs = append(pairs, value, RedactedValue)
+	}
+	redactor.replacer = strings.NewReplacer(pairs...)
+	return redactor.replacer
+}
+
+// RedactingWriter : Writes redacted text to an underlying writer. Text is written a line at a time, so that a value
+// split across two writes is still redacted; call Flush to write a trailing partial line.
+type RedactingWriter struct {
+	redactor *Redactor
+	writer   io.Writer
+	buffer   bytes.Buffer
+}
+
+// Writer returns a writer that redacts the text written to it before writing it to w.
+func (redactor *Redactor) Writer(w io.Writer) *RedactingWriter {
+	return &RedactingWriter{redactor: redactor, writer: w}
+}
+
+// Write buffers p and writes the redacted complete lines.
+func (writer *RedactingWriter) Write(p []byte) (int, error) {
+	writer.buffer.Write(p)
+	if i := bytes.LastIndexByte(writer.buffer.Bytes(), '\n'); i >= 0 {
+		lines := writer.buffer.Next(i + 1)
+		if _, err := io.WriteString(writer.writer, writer.redactor.Redact(string(lines))); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes the redacted remainder of the buffered text.
+func (writer *RedactingWriter) Flush() error {
+	if writer.buffer.Len() == 0 {
+		return nil
+	}
+	rest := writer.buffer.String()
+	writer.buffer.Reset()
+	_, err := io.WriteString(writer.writer, writer.redactor.Redact(rest))
+	return err
+}
+
+// The following types have the fields of the models but none of their methods, so that redacted copies can be
+// printed without recursing into the Format and LogValue methods below.
+type (
+	plainProperty                       Property
+	plainTriggerProperty                TriggerProperty
+	plainPipelineRun                    PipelineRun
+	plainTektonPipeline                 TektonPipeline
+	plainPipelineRunTrigger             PipelineRunTrigger
+	plainCreateTektonPipelineRunOptions CreateTektonPipelineRunOptions
+)
+
+// Format prints the property with its value redacted if it is secure.
+func (property Property) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), plainProperty(redactProperty(property)))
+}
+
+// LogValue returns the property with its value redacted if it is secure.
+func (property Property) LogValue() slog.Value {
+	return slog.AnyValue(plainProperty(redactProperty(property)))
+}
+
+// Format prints the trigger property with its value redacted if it is secure.
+func (property TriggerProperty) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), plainTriggerProperty(redactTriggerProperty(property)))
+}
+
+// LogValue returns the trigger property with its value redacted if it is secure.
+func (property TriggerProperty) LogValue() slog.Value {
+	return slog.AnyValue(plainTriggerProperty(redactTriggerProperty(property)))
+}
+
+// Format prints the pipeline run with the values of its secure properties redacted.
+func (pipelineRun PipelineRun) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), plainPipelineRun(redactPipelineRun(pipelineRun)))
+}
+
+// LogValue returns the pipeline run with the values of its secure properties redacted.
+func (pipelineRun PipelineRun) LogValue() slog.Value {
+	return slog.AnyValue(plainPipelineRun(redactPipelineRun(pipelineRun)))
+}
+
+// Format prints the pipeline with the values of its secure properties and secure trigger properties redacted.
+func (tektonPipeline TektonPipeline) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), plainTektonPipeline(redactTektonPipeline(tektonPipeline)))
+}
+
+// LogValue returns the pipeline with the values of its secure properties and secure trigger properties redacted.
+func (tektonPipeline TektonPipeline) LogValue() slog.Value {
+	return slog.AnyValue(plainTektonPipeline(redactTektonPipeline(tektonPipeline)))
+}
+
+// Format prints the trigger with its secure properties redacted.
+func (pipelineRunTrigger PipelineRunTrigger) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), plainPipelineRunTrigger(redactPipelineRunTrigger(pipelineRunTrigger)))
+}
+
+// LogValue returns the trigger with its secure properties redacted.
+func (pipelineRunTrigger PipelineRunTrigger) LogValue() slog.Value {
+	return slog.AnyValue(plainPipelineRunTrigger(redactPipelineRunTrigger(pipelineRunTrigger)))
+}
+
+// Format prints the options with the secure trigger properties redacted.
+func (_options CreateTektonPipelineRunOptions) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), plainCreateTektonPipelineRunOptions(redactCreateTektonPipelineRunOptions(_options)))
+}
+
+// LogValue returns the options with the secure trigger properties redacted.
+func (_options CreateTektonPipelineRunOptions) LogValue() slog.Value {
+	return slog.AnyValue(plainCreateTektonPipelineRunOptions(redactCreateTektonPipelineRunOptions(_options)))
+}
+
+// RedactedModel : Wraps a model so that printing it with the fmt package, or logging it with log/slog, shows the values
+// of its secure properties as RedactedValue. The wrapped model is not modified.
+type RedactedModel struct {
+	model interface{}
+}
+
+// Redacted wraps a model for printing its redacted copy as JSON, for example `fmt.Printf("%v", Redacted(run))`. The
+// models redact themselves when printed or logged directly; the wrapper only adds the JSON output, which shows the
+// values behind their pointer fields. Property, TriggerProperty, slices of them, PipelineRun, TektonPipeline,
+// PipelineRunTrigger and CreateTektonPipelineRunOptions are redacted, whether passed as values or pointers. Other
+// values are printed unchanged.
+func Redacted(model interface{}) RedactedModel {
+	return RedactedModel{model: model}
+}
+
+// Format prints the redacted model, as JSON for the %v and %s verbs.
+func (redacted RedactedModel) Format(f fmt.State, verb rune) {
+	if model, ok := redactModel(redacted.model); ok {
+		formatRedacted(f, verb, model)
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), redacted.model)
+}
+
+// LogValue returns the redacted model.
+func (redacted RedactedModel) LogValue() slog.Value {
+	model, _ := redactModel(redacted.model)
+	return slog.AnyValue(model)
+}
+
+// redactModel returns a copy of a model with the values of its secure properties redacted. It returns false, and the
+// value unchanged, if the value is not a model that holds secure values.
+func redactModel(model interface{}) (interface{}, bool) {
+	switch model := model.(type) {
+	case Property:
+		return redactProperty(model), true
+	case *Property:
+		if model != nil {
+			return redactProperty(*model), true
+		}
+	case []Property:
+		return redactProperties(model), true
+	case TriggerProperty:
+		return redactTriggerProperty(model), true
+	case *TriggerProperty:
+		if model != nil {
+			return redactTriggerProperty(*model), true
+		}
+	case []TriggerProperty:
+		return redactTriggerProperties(model), true
+	case PipelineRun:
+		return redactPipelineRun(model), true
+	case *PipelineRun:
+		if model != nil {
+			return redactPipelineRun(*model), true
+		}
+	case TektonPipeline:
+		return redactTektonPipeline(model), true
+	case *TektonPipeline:
+		if model != nil {
+			return redactTektonPipeline(*model), true
+		}
+	case PipelineRunTrigger:
+		return redactPipelineRunTrigger(model), true
+	case *PipelineRunTrigger:
+		if model != nil {
+			return redactPipelineRunTrigger(*model), true
+		}
+	case CreateTektonPipelineRunOptions:
+		return redactCreateTektonPipelineRunOptions(model), true
+	case *CreateTektonPipelineRunOptions:
+		if model != nil {
+			return redactCreateTektonPipelineRunOptions(*model), true
+		}
+	}
+	return model, false
+}
+
+// formatRedacted prints a redacted copy of a model. The models hold pointers, which the default format prints as
+// addresses, so the %v and %s verbs print JSON instead.
+func formatRedacted(f fmt.State, verb rune, redacted interface{}) {
+	if verb == 'v' && !f.Flag('#') || verb == 's' {
+		if data, err := json.Marshal(redacted); err == nil {
+			f.Write(data)
+			return
+		}
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), redacted)
+}
+
+func redactProperty(property Property) Property {
+	if core.StringNilMapper(property.Type) == PropertyTypeSecureConst && property.Value != nil {
+		property.Value = core.StringPtr(RedactedValue)
+	}
+	return property
+}
+
+func redactTriggerProperty(property TriggerProperty) TriggerProperty {
+	if core.StringNilMapper(property.Type) == TriggerPropertyTypeSecureConst && property.Value != nil {
+		property.Value = core.StringPtr(RedactedValue)
+	}
+	return property
+}
+
+func redactProperties(properties []Property) []Property {
+	if properties == nil {
+		return nil
+	}
+	redacted := make([]Property, len(properties))
+	for i, property := range properties {
+		redacted[i] = redactProperty(property)
+	}
+	return redacted
+}
+
+func redactTriggerProperties(properties []TriggerProperty) []TriggerProperty {
+	if properties == nil {
+		return nil
+	}
+	redacted := make([]TriggerProperty, len(properties))
+	for i, property := range properties {
+		redacted[i] = redactTriggerProperty(property)
+	}
+	return redacted
+}
+
+// redactValues returns a copy of a secure properties map with every value redacted.
+func redactValues(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(values))
+	for name := range values {
+		redacted[name] = RedactedValue
+	}
+	return redacted
+}
+
+func redactPipelineRun(pipelineRun PipelineRun) PipelineRun {
+	pipelineRun.Properties = redactProperties(pipelineRun.Properties)
+	if trigger := AsTrigger(pipelineRun.Trigger); trigger != nil {
+		redacted := *trigger
+		redacted.Properties = redactTriggerProperties(trigger.Properties)
+		pipelineRun.Trigger = &redacted
+	}
+	return pipelineRun
+}
+
+func redactTektonPipeline(tektonPipeline TektonPipeline) TektonPipeline {
+	tektonPipeline.Properties = redactProperties(tektonPipeline.Properties)
+	if tektonPipeline.Triggers != nil {
+		triggers := make([]TriggerIntf, len(tektonPipeline.Triggers))
+		for i, triggerIntf := range tektonPipeline.Triggers {
+			triggers[i] = triggerIntf
+			if trigger := AsTrigger(triggerIntf); trigger != nil {
+				redacted := *trigger
+				redacted.Properties = redactTriggerProperties(trigger.Properties)
+				triggers[i] = &redacted
+			}
+		}
+		tektonPipeline.Triggers = triggers
+	}
+	return tektonPipeline
+}
+
+func redactPipelineRunTrigger(pipelineRunTrigger PipelineRunTrigger) PipelineRunTrigger {
+	pipelineRunTrigger.SecureProperties = redactValues(pipelineRunTrigger.SecureProperties)
+	return pipelineRunTrigger
+}
+
+func redactCreateTektonPipelineRunOptions(options CreateTektonPipelineRunOptions) CreateTektonPipelineRunOptions {
+	options.SecureTriggerProperties = redactValues(options.SecureTriggerProperties)
+	if options.Trigger != nil {
+		trigger := *options.Trigger
+		trigger.SecureProperties = redactValues(trigger.SecureProperties)
+		options.Trigger = &trigger
+	}
+	return options
+}
@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Redactor`, func() {
+	const secret = "s3cr3t/token+value"
+	pipeline := &cdtektonpipelinev2.TektonPipeline{
+		Properties: []cdtektonpipelinev2.Property{
+			{Name: core.StringPtr("api-key"), Type: core.StringPtr("secure"), Value: core.StringPtr(secret)},
+			{Name: core.StringPtr("env"), Type: core.StringPtr("text"), Value: core.StringPtr("production")},
+		},
+		Triggers: []cdtektonpipelinev2.TriggerIntf{
+			&cdtektonpipelinev2.Trigger{Name: core.StringPtr("ci"), Properties: []cdtektonpipelinev2.TriggerProperty{
+				{Name: core.StringPtr("password"), Type: core.StringPtr("secure"), Value: core.StringPtr("hunter22")},
+			}},
+		},
+	}
+
+	It(`Masks secure values and their encoded forms`, func() {
+		redactor := cdtektonpipelinev2.NewPipelineRedactor(pipeline, map[string]interface{}{"override": "runtime-secret"})
+		text := fmt.Sprintf("key=%s b64=%s url=%s pw=hunter22 rt=runtime-secret env=production",
+			secret, base64.StdEncoding.EncodeToString([]byte(secret)), url.QueryEscape(secret))
+		Expect(redactor.Redact(text)).To(Equal("key=[REDACTED] b64=[REDACTED] url=[REDACTED] pw=[REDACTED] rt=[REDACTED] env=production"))
+	})
+	It(`Ignores values that are too short to redact safely`, func() {
+		Expect(cdtektonpipelinev2.NewRedactor("abc").Redact("abcdef")).To(Equal("abcdef"))
+	})
+	It(`Redacts streams across writes`, func() {
+		var out bytes.Buffer
+		writer := cdtektonpipelinev2.NewRedactor(secret).Writer(&out)
+		_, err := writer.Write([]byte("login with s3cr3t/to"))
+		Expect(err).To(BeNil())
+		Expect(out.String()).To(Equal(""))
+		_, err = writer.Write([]byte("ken+value\ndone " + secret))
+		Expect(err).To(BeNil())
+		Expect(out.String()).To(Equal("login with [REDACTED]\n"))
+		Expect(writer.Flush()).To(Succeed())
+		Expect(out.String()).To(Equal("login with [REDACTED]\ndone [REDACTED]"))
+	})
+	It(`Redacts secure values when models are formatted or logged`, func() {
+		options := &cdtektonpipelinev2.CreateTektonPipelineRunOptions{
+			SecureTriggerProperties: map[string]interface{}{"token": secret},
+			Trigger:                 &cdtektonpipelinev2.PipelineRunTrigger{Name: core.StringPtr("ci"), SecureProperties: map[string]interface{}{"token": secret}},
+		}
+		for _, format := range []string{"%v", "%+v"} {
+			Expect(fmt.Sprintf(format, options)).ToNot(ContainSubstring(secret), format)
+			Expect(fmt.Sprintf(format, *options)).To(ContainSubstring("[REDACTED]"), format)
+			Expect(fmt.Sprintf(format, options.Trigger)).ToNot(ContainSubstring(secret), format)
+			Expect(fmt.Sprintf(format, *options.Trigger)).To(ContainSubstring("[REDACTED]"), format)
+		}
+		Expect(fmt.Sprintf("%+v", *options.Trigger)).To(ContainSubstring("SecureProperties:map[token:[REDACTED]]"))
+
+		var out bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&out, nil))
+		logger.Info("run", "pipeline", pipeline, "run", &cdtektonpipelinev2.PipelineRun{Properties: pipeline.Properties}, "options", options)
+		Expect(out.String()).ToNot(ContainSubstring(secret))
+		Expect(out.String()).ToNot(ContainSubstring("hunter22"))
+		Expect(out.String()).To(ContainSubstring(`"trigger":{"name":"ci","secure_properties":{"token":"[REDACTED]"}}`))
+		Expect(out.String()).To(ContainSubstring("production"))
+		Expect(options.SecureTriggerProperties["token"]).To(Equal(secret))
+		Expect(*pipeline.Properties[0].Value).To(Equal(secret))
+	})
+	It(`Redacts secure values when wrapped models are formatted`, func() {
+		output := fmt.Sprintf("%+v %v", cdtektonpipelinev2.Redacted(pipeline.Properties), cdtektonpipelinev2.Redacted(*pipeline))
+		Expect(output).ToNot(ContainSubstring(secret))
+		Expect(output).To(ContainSubstring("production"))
+		Expect(output).To(ContainSubstring("[REDACTED]"))
+
+		options := &cdtektonpipelinev2.CreateTektonPipelineRunOptions{
+			SecureTriggerProperties: map[string]interface{}{"token": secret},
+			Trigger:                 &cdtektonpipelinev2.PipelineRunTrigger{Name: core.StringPtr("ci"), SecureProperties: map[string]interface{}{"token": secret}},
+		}
+		Expect(fmt.Sprintf("%v", cdtektonpipelinev2.Redacted(options))).ToNot(ContainSubstring(secret))
+		Expect(fmt.Sprintf("%+v", cdtektonpipelinev2.Redacted(*options.Trigger))).To(ContainSubstring(`"secure_properties":{"token":"[REDACTED]"}`))
+		Expect(options.SecureTriggerProperties["token"]).To(Equal(secret))
+		Expect(fmt.Sprintf("%v", cdtektonpipelinev2.Redacted("unchanged"))).To(Equal("unchanged"))
+	})
+	It(`Redacts secure values when wrapped models are logged`, func() {
+		var out bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&out, nil))
+		logger.Info("pipeline", "pipeline", cdtektonpipelinev2.Redacted(pipeline),
+			"run", cdtektonpipelinev2.Redacted(cdtektonpipelinev2.PipelineRun{Properties: pipeline.Properties}))
+		Expect(out.String()).ToNot(ContainSubstring(secret))
+		Expect(out.String()).ToNot(ContainSubstring("hunter22"))
+		Expect(out.String()).To(ContainSubstring("production"))
+		Expect(*pipeline.Properties[0].Value).To(Equal(secret))
+	})
+})
@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ReplayOverrides : Changes applied to the replayed event of ReplayTektonPipelineRun.
+type ReplayOverrides struct {
+	// Text properties added to the run or overriding existing properties.
+	Properties map[string]interface{}
+
+	// Secure properties added to the run or overriding existing secure properties.
+	SecureProperties map[string]interface{}
+
+	// Headers added to the original headers or replacing them.
+	Headers map[string]interface{}
+
+	// Names of original headers to leave out, for example a signature that no longer matches the body.
+	RemoveHeaders []string
+
+	// Top-level fields added to the original body or replacing them.
+	Body map[string]interface{}
+
+	// The description of the new run. The default is `replay of run <runID>`.
+	Description string
+}
+
+// ReplayTektonPipelineRun starts a new run of a manual or generic trigger with the event that started an earlier run.
+// The earlier run's EventParamsBlob becomes the body of the new run and its TriggerHeaders become the headers, after
+// the overrides have been applied. Unlike RerunTektonPipelineRun, the new run can use a different trigger than the
+// original one.
+func (cdTektonPipeline *CdTektonPipelineV2) ReplayTektonPipelineRun(ctx context.Context, pipelineID string, runID string, targetTrigger string, overrides *ReplayOverrides) (result *PipelineRun, err error) {
+	if overrides == nil {
+		overrides = &ReplayOverrides{}
+	}
+
+	trigger, err := cdTektonPipeline.findTrigger(ctx, pipelineID, targetTrigger)
+	if err != nil {
+		return
+	}
+	if triggerType := core.StringNilMapper(trigger.Type); triggerType != "manual" && triggerType != "generic" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("trigger %s is a %s trigger, only manual and generic triggers can replay events", targetTrigger, triggerType), "invalid-replay-trigger", common.GetComponentInfo())
+		return
+	}
+
+	run, _, err := cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineRunOptions(pipelineID, runID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "replay-get-run-error")
+		return
+	}
+	body, headers, err := replayEvent(run)
+	if err != nil {
+		return
+	}
+	for _, name := range overrides.RemoveHeaders {
+		delete(headers, name)
+	}
+	for name, value := range overrides.Headers {
+		headers[name] = value
+	}
+	for name, value := range overrides.Body {
+		body[name] = value
+	}
+
+	runTrigger := &PipelineRunTrigger{
+		Name:             core.StringPtr(targetTrigger),
+		Properties:       overrides.Properties,
+		SecureProperties: overrides.SecureProperties,
+	}
+	if len(body) > 0 {
+		runTrigger.Body = body
+	}
+	if len(headers) > 0 {
+		runTrigger.HeadersVar = headers
+	}
+	description := overrides.Description
+	if description == "" {
+		description = "replay of run " + runID
+	}
+	createOptions := cdTektonPipeline.NewCreateTektonPipelineRunOptions(pipelineID)
+	createOptions.SetDescription(description)
+	createOptions.SetTrigger(runTrigger)
+	result, _, err = cdTektonPipeline.CreateTektonPipelineRunWithContext(ctx, createOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "replay-create-run-error")
+	}
+	return
+}
+
+// replayEvent decodes the event body and headers of a run. Event parameters that wrap the payload in `body` and
+// `headers` fields are unwrapped; headers found there are used when the run has no TriggerHeaders. The returned maps are
+// never nil, even when the run holds JSON `null`.
+func replayEvent(run *PipelineRun) (body map[string]interface{}, headers map[string]interface{}, err error) {
+	body = map[string]interface{}{}
+	headers = map[string]interface{}{}
+	if blob := core.StringNilMapper(run.EventParamsBlob); blob != "" {
+		if err = json.Unmarshal([]byte(blob), &body); err != nil {
+			err = core.SDKErrorf(err, "the event parameters of the run are not a JSON object", "invalid-event-params", common.GetComponentInfo())
+			return
+		}
+		if wrapped, ok := body["body"].(map[string]interface{}); ok {
+			if wrappedHeaders, ok := body["headers"].(map[string]interface{}); ok {
+				headers = wrappedHeaders
+			}
+			body = wrapped
+		}
+		if body == nil {
+			body = map[string]interface{}{}
+		}
+	}
+	if triggerHeaders := core.StringNilMapper(run.TriggerHeaders); triggerHeaders != "" {
+		headers = map[string]interface{}{}
+		if err = json.Unmarshal([]byte(triggerHeaders), &headers); err != nil {
+			err = core.SDKErrorf(err, "the trigger headers of the run are not a JSON object", "invalid-trigger-headers", common.GetComponentInfo())
+			return
+		}
+		if headers == nil {
+			headers = map[string]interface{}{}
+		}
+	}
+	return
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ReplayTektonPipelineRun(ctx, pipelineID, runID, targetTrigger, overrides)`, func() {
+	var testServer *httptest.Server
+	var created map[string]interface{}
+	BeforeEach(func() {
+		created = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1/triggers":
+				res.WriteHeader(200)
+				switch req.URL.Query().Get("name") {
+				case "replay":
+					fmt.Fprintf(res, "%s", `{"triggers": [{"type": "manual", "id": "trigger-1", "name": "replay"}]}`)
+				case "git":
+					fmt.Fprintf(res, "%s", `{"triggers": [{"type": "scm", "id": "trigger-2", "name": "git"}]}`)
+				default:
+					fmt.Fprintf(res, "%s", `{"triggers": []}`)
+				}
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "run-1", "status": "failed",
+					"event_params_blob": "{\"ref\": \"refs/heads/main\", \"after\": \"abc123\", \"repository\": {\"name\": \"app\"}}",
+					"trigger_headers": "{\"X-GitHub-Event\": \"push\", \"X-Hub-Signature-256\": \"sha256=1234\"}"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-2":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "run-2", "status": "failed",
+					"event_params_blob": "{\"body\": {\"action\": \"deploy\"}, \"headers\": {\"Token\": \"t\"}}"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-4":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "run-4", "status": "failed", "event_params_blob": "null", "trigger_headers": "null"}`)
+			case "POST /tekton_pipelines/pipeline-1/pipeline_runs":
+				Expect(json.NewDecoder(req.Body).Decode(&created)).To(Succeed())
+				res.WriteHeader(201)
+				fmt.Fprintf(res, "%s", `{"id": "run-3", "status": "pending"}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	It(`Replays the event body and headers with overrides`, func() {
+		run, err := newService().ReplayTektonPipelineRun(context.Background(), "pipeline-1", "run-1", "replay", &cdtektonpipelinev2.ReplayOverrides{
+			Properties:    map[string]interface{}{"debug": "true"},
+			RemoveHeaders: []string{"X-Hub-Signature-256"},
+			Headers:       map[string]interface{}{"X-Replayed-From": "run-1"},
+			Body:          map[string]interface{}{"after": "def456"},
+		})
+		Expect(err).To(BeNil())
+		Expect(*run.ID).To(Equal("run-3"))
+		Expect(created["description"]).To(Equal("replay of run run-1"))
+		Expect(created["trigger"]).To(Equal(map[string]interface{}{
+			"name":       "replay",
+			"properties": map[string]interface{}{"debug": "true"},
+			"headers":    map[string]interface{}{"X-GitHub-Event": "push", "X-Replayed-From": "run-1"},
+			"body": map[string]interface{}{
+				"ref":        "refs/heads/main",
+				"after":      "def456",
+				"repository": map[string]interface{}{"name": "app"},
+			},
+		}))
+	})
+	It(`Unwraps events that carry a body and headers`, func() {
+		_, err := newService().ReplayTektonPipelineRun(context.Background(), "pipeline-1", "run-2", "replay", nil)
+		Expect(err).To(BeNil())
+		Expect(created["trigger"]).To(Equal(map[string]interface{}{
+			"name":    "replay",
+			"headers": map[string]interface{}{"Token": "t"},
+			"body":    map[string]interface{}{"action": "deploy"},
+		}))
+	})
+	It(`Applies overrides to events and headers that are null`, func() {
+		_, err := newService().ReplayTektonPipelineRun(context.Background(), "pipeline-1", "run-4", "replay", &cdtektonpipelinev2.ReplayOverrides{
+			Headers: map[string]interface{}{"X-Replayed-From": "run-4"},
+			Body:    map[string]interface{}{"action": "deploy"},
+		})
+		Expect(err).To(BeNil())
+		Expect(created["trigger"]).To(Equal(map[string]interface{}{
+			"name":    "replay",
+			"headers": map[string]interface{}{"X-Replayed-From": "run-4"},
+			"body":    map[string]interface{}{"action": "deploy"},
+		}))
+	})
+	It(`Rejects triggers that cannot replay events`, func() {
+		_, err := newService().ReplayTektonPipelineRun(context.Background(), "pipeline-1", "run-1", "git", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("only manual and generic triggers"))
+		_, err = newService().ReplayTektonPipelineRun(context.Background(), "pipeline-1", "run-1", "missing", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(created).To(BeNil())
+	})
+})
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RerunPolicy : Describes which failed pipeline runs are rerun automatically, and how often.
+type RerunPolicy struct {
+	// Patterns that identify infrastructural failures, such as worker eviction or registry timeouts. A failed run is
+	// rerun only if one of them matches its error message, or its step logs when MatchStepLogs is true.
+	Patterns []*regexp.Regexp
+
+	// Also match the patterns against the content of every step log of the failed run.
+	MatchStepLogs bool
+
+	// The maximum number of reruns.
+	MaxReruns int
+
+	// The wait before the first rerun. It doubles for every further rerun.
+	Backoff time.Duration
+
+	// The maximum wait between two reruns. No limit when zero.
+	MaxBackoff time.Duration
+
+	// Options used while waiting on each run.
+	WaitOptions *WaitOptions
+}
+
+// RerunAttempt : One run in a chain of reruns.
+type RerunAttempt struct {
+	// The run, in its terminal state.
+	Run *PipelineRun
+
+	// The pattern that matched the failure of the run, "" when none matched.
+	MatchedPattern string
+
+	// Where the pattern matched: "error_message" or the name of a step log.
+	MatchedIn string
+
+	// Describes the position of the run in the rerun chain. The rerun endpoint does not accept a request body, so the
+	// service cannot store this in the run's own Description.
+	Description string
+}
+
+// RerunResult : The outcome of applying a RerunPolicy.
+type RerunResult struct {
+	// The last run of the chain.
+	Final *PipelineRun
+
+	// Every run of the chain, starting with the original run.
+	Attempts []RerunAttempt
+}
+
+// Succeeded returns true if the last run of the chain succeeded.
+func (result *RerunResult) Succeeded() bool {
+	return result.Final != nil && result.Final.Status != nil && *result.Final.Status == PipelineRunStatusSucceededConst
+}
+
+// ApplyRerunPolicy waits for a pipeline run to finish. When it fails with an error that matches the policy, the run is
+// rerun with RerunTektonPipelineRun after a backoff, and the rerun is watched in turn, up to policy.MaxReruns times.
+// The whole chain is returned, also when an error stops it early.
+func (cdTektonPipeline *CdTektonPipelineV2) ApplyRerunPolicy(ctx context.Context, pipelineID string, runID string, policy *RerunPolicy) (result *RerunResult, err error) {
+	err = core.ValidateNotNil(policy, "policy cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	result = &RerunResult{}
+	currentID := runID
+	for attempt := 0; ; attempt++ {
+		var run *PipelineRun
+		run, err = cdTektonPipeline.WaitForTektonPipelineRun(ctx, pipelineID, currentID, policy.WaitOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "rerun-wait-error")
+			return
+		}
+		result.Final = run
+
+		entry := RerunAttempt{Run: run, Description: rerunDescription(runID, result.Attempts)}
+		if isFailedStatus(run.Status) {
+			entry.MatchedPattern, entry.MatchedIn, err = cdTektonPipeline.matchRerunPatterns(ctx, pipelineID, run, policy)
+		}
+		result.Attempts = append(result.Attempts, entry)
+		if err != nil || entry.MatchedPattern == "" || attempt >= policy.MaxReruns {
+			return
+		}
+
+		if err = sleepContext(ctx, rerunBackoff(policy, attempt)); err != nil {
+			err = core.SDKErrorf(err, "", "rerun-cancelled", common.GetComponentInfo())
+			return
+		}
+		var rerun *PipelineRun
+		rerun, _, err = cdTektonPipeline.RerunTektonPipelineRunWithContext(ctx, cdTektonPipeline.NewRerunTektonPipelineRunOptions(pipelineID, *run.ID))
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "rerun-error")
+			return
+		}
+		currentID = *rerun.ID
+	}
+}
+
+// matchRerunPatterns returns the first pattern that matches the error message of the run or, if enabled, its step logs.
+func (cdTektonPipeline *CdTektonPipelineV2) matchRerunPatterns(ctx context.Context, pipelineID string, run *PipelineRun, policy *RerunPolicy) (pattern string, matchedIn string, err error) {
+	if run.ErrorMessage != nil {
+		for _, p := range policy.Patterns {
+			if p.MatchString(*run.ErrorMessage) {
+				return p.String(), "error_message", nil
+			}
+		}
+	}
+	if !policy.MatchStepLogs {
+		return
+	}
+	stepLogs, err := cdTektonPipeline.getStepLogs(ctx, pipelineID, *run.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "rerun-logs-error")
+		return
+	}
+	for _, entry := range stepLogs {
+		for _, p := range policy.Patterns {
+			if p.MatchString(entry.Data) {
+				return p.String(), core.StringNilMapper(entry.Log.Name), nil
+			}
+		}
+	}
+	return
+}
+
+func isFailedStatus(status *string) bool {
+	return status != nil && (*status == PipelineRunStatusFailedConst || *status == PipelineRunStatusErrorConst)
+}
+
+func rerunDescription(originalID string, previous []RerunAttempt) string {
+	if len(previous) == 0 {
+		return fmt.Sprintf("original run %s", originalID)
+	}
+	return fmt.Sprintf("rerun %d of run %s, rerunning %s", len(previous), originalID, *previous[len(previous)-1].Run.ID)
+}
+
+func rerunBackoff(policy *RerunPolicy, attempt int) time.Duration {
+	backoff := policy.Backoff
+	for i := 0; i < attempt && (policy.MaxBackoff <= 0 || backoff < policy.MaxBackoff); i++ {
+		backoff *= 2
+	}
+	if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+	return backoff
+}
+
+// sleepContext waits for the specified duration or until ctx is done.
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ApplyRerunPolicy(ctx, pipelineID, runID, policy)`, func() {
+	var testServer *httptest.Server
+	var reruns int
+	BeforeEach(func() {
+		reruns = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-1":
+				fmt.Fprintf(res, "%s", `{"id": "run-1", "status": "failed", "error_message": "The pod was evicted"}`)
+			case "POST /tekton_pipelines/pipeline-1/pipeline_runs/run-1/rerun":
+				reruns++
+				fmt.Fprintf(res, "%s", `{"id": "run-2", "status": "pending"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-2":
+				fmt.Fprintf(res, "%s", `{"id": "run-2", "status": "failed"}`)
+			case "POST /tekton_pipelines/pipeline-1/pipeline_runs/run-2/rerun":
+				reruns++
+				fmt.Fprintf(res, "%s", `{"id": "run-3", "status": "pending"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-2/logs":
+				fmt.Fprintf(res, "%s", `{"logs": [{"id": "log-1", "name": "pod/step-build"}]}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-2/logs/log-1":
+				fmt.Fprintf(res, "%s", `{"id": "log-1", "data": "pulling image\nerror: registry timeout\n"}`)
+			case "GET /tekton_pipelines/pipeline-1/pipeline_runs/run-3":
+				fmt.Fprintf(res, "%s", `{"id": "run-3", "status": "succeeded"}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	It(`Reruns matching failures until the run succeeds`, func() {
+		result, err := newService().ApplyRerunPolicy(context.Background(), "pipeline-1", "run-1", &cdtektonpipelinev2.RerunPolicy{
+			Patterns:      []*regexp.Regexp{regexp.MustCompile(`evicted`), regexp.MustCompile(`registry timeout`)},
+			MatchStepLogs: true,
+			MaxReruns:     3,
+			Backoff:       time.Millisecond,
+			WaitOptions:   &cdtektonpipelinev2.WaitOptions{PollInterval: time.Millisecond},
+		})
+		Expect(err).To(BeNil())
+		Expect(reruns).To(Equal(2))
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(*result.Final.ID).To(Equal("run-3"))
+		Expect(result.Attempts).To(HaveLen(3))
+		Expect(result.Attempts[0].MatchedPattern).To(Equal("evicted"))
+		Expect(result.Attempts[0].MatchedIn).To(Equal("error_message"))
+		Expect(result.Attempts[1].MatchedPattern).To(Equal("registry timeout"))
+		Expect(result.Attempts[1].MatchedIn).To(Equal("pod/step-build"))
+		Expect(result.Attempts[2].Description).To(Equal("rerun 2 of run run-1, rerunning run-2"))
+	})
+	It(`Stops after MaxReruns`, func() {
+		result, err := newService().ApplyRerunPolicy(context.Background(), "pipeline-1", "run-1", &cdtektonpipelinev2.RerunPolicy{
+			Patterns:      []*regexp.Regexp{regexp.MustCompile(`evicted|registry timeout`)},
+			MatchStepLogs: true,
+			MaxReruns:     1,
+		})
+		Expect(err).To(BeNil())
+		Expect(reruns).To(Equal(1))
+		Expect(result.Succeeded()).To(BeFalse())
+		Expect(*result.Final.ID).To(Equal("run-2"))
+	})
+	It(`Does not rerun failures that do not match`, func() {
+		result, err := newService().ApplyRerunPolicy(context.Background(), "pipeline-1", "run-1", &cdtektonpipelinev2.RerunPolicy{
+			Patterns:  []*regexp.Regexp{regexp.MustCompile(`out of memory`)},
+			MaxReruns: 3,
+		})
+		Expect(err).To(BeNil())
+		Expect(reruns).To(Equal(0))
+		Expect(result.Attempts).To(HaveLen(1))
+		Expect(result.Attempts[0].MatchedPattern).To(Equal(""))
+	})
+	It(`Rejects a nil policy`, func() {
+		result, err := newService().ApplyRerunPolicy(context.Background(), "pipeline-1", "run-1", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+	})
+})
@@ -0,0 +1,250 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ValidateSingleSelect checks the options and value of a `single_select` property: there must be at least one
+// option, options must be unique, and the value must be one of them.
+func ValidateSingleSelect(enum []string, value string) error {
+	if len(enum) == 0 {
+		return core.SDKErrorf(nil, "a single_select property must have at least one option", "invalid-single-select", common.GetComponentInfo())
+	}
+	seen := map[string]bool{}
+	for _, option := range enum {
+		if seen[option] {
+			return core.SDKErrorf(nil, fmt.Sprintf("the option %q is listed more than once", option), "invalid-single-select", common.GetComponentInfo())
+		}
+		seen[option] = true
+	}
+	if !seen[value] {
+		return core.SDKErrorf(nil, fmt.Sprintf("the value %q is not one of the options %s", value, quoteOptions(enum)), "invalid-single-select", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// validateSingleSelect applies ValidateSingleSelect to the fields of property options of type `single_select` that
+// set a value.
+func validateSingleSelect(propertyType *string, value *string, enum []string) error {
+	if core.StringNilMapper(propertyType) != PropertyTypeSingleSelectConst || value == nil {
+		return nil
+	}
+	return ValidateSingleSelect(enum, *value)
+}
+
+// ValidateSingleSelect checks the options and value of the property with ValidateSingleSelect if it is of type
+// `single_select` and sets a value. The generated CreateTektonPipelineProperties does not call it, so invalid options
+// are only rejected by the service; call this first to fail before the request is sent.
+func (_options *CreateTektonPipelinePropertiesOptions) ValidateSingleSelect() error {
+	return validateSingleSelect(_options.Type, _options.Value, _options.Enum)
+}
+
+// ValidateSingleSelect checks the options and value of the property with ValidateSingleSelect if it is of type
+// `single_select` and sets a value. The generated ReplaceTektonPipelineProperty does not call it; call this first.
+func (_options *ReplaceTektonPipelinePropertyOptions) ValidateSingleSelect() error {
+	return validateSingleSelect(_options.Type, _options.Value, _options.Enum)
+}
+
+// ValidateSingleSelect checks the options and value of the trigger property with ValidateSingleSelect if it is of type
+// `single_select` and sets a value. The generated CreateTektonPipelineTriggerProperties does not call it; call this
+// first.
+func (_options *CreateTektonPipelineTriggerPropertiesOptions) ValidateSingleSelect() error {
+	return validateSingleSelect(_options.Type, _options.Value, _options.Enum)
+}
+
+// ValidateSingleSelect checks the options and value of the trigger property with ValidateSingleSelect if it is of type
+// `single_select` and sets a value. The generated ReplaceTektonPipelineTriggerProperty does not call it; call this
+// first.
+func (_options *ReplaceTektonPipelineTriggerPropertyOptions) ValidateSingleSelect() error {
+	return validateSingleSelect(_options.Type, _options.Value, _options.Enum)
+}
+
+// AddSingleSelectOptions adds options to a `single_select` pipeline property, keeping its value and whether it is
+// locked. Options it already has are ignored.
+func (cdTektonPipeline *CdTektonPipelineV2) AddSingleSelectOptions(ctx context.Context, pipelineID string, name string, options ...string) (*Property, error) {
+	return cdTektonPipeline.updateSingleSelect(ctx, pipelineID, name, func(property *Property) error {
+		for _, option := range options {
+			if !containsString(property.Enum, option) {
+				property.Enum = append(property.Enum, option)
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveSingleSelectOptions removes options from a `single_select` pipeline property, keeping whether it is locked.
+// The selected value cannot be removed; select another value first.
+func (cdTektonPipeline *CdTektonPipelineV2) RemoveSingleSelectOptions(ctx context.Context, pipelineID string, name string, options ...string) (*Property, error) {
+	return cdTektonPipeline.updateSingleSelect(ctx, pipelineID, name, func(property *Property) error {
+		value := core.StringNilMapper(property.Value)
+		var remaining []string
+		for _, option := range property.Enum {
+			if !containsString(options, option) {
+				remaining = append(remaining, option)
+			} else if option == value {
+				return core.SDKErrorf(nil, fmt.Sprintf("cannot remove the option %q of property %s because it is the selected value", option, name), "remove-selected-option", common.GetComponentInfo())
+			}
+		}
+		property.Enum = remaining
+		return nil
+	})
+}
+
+// SelectSingleSelectValue changes the value of a `single_select` pipeline property, which is the default selection
+// of runs, keeping whether it is locked. The value must be one of its options.
+func (cdTektonPipeline *CdTektonPipelineV2) SelectSingleSelectValue(ctx context.Context, pipelineID string, name string, value string) (*Property, error) {
+	return cdTektonPipeline.updateSingleSelect(ctx, pipelineID, name, func(property *Property) error {
+		property.Value = core.StringPtr(value)
+		return nil
+	})
+}
+
+// updateSingleSelect reads a `single_select` pipeline property, applies a change to it and replaces it.
+func (cdTektonPipeline *CdTektonPipelineV2) updateSingleSelect(ctx context.Context, pipelineID string, name string, change func(property *Property) error) (*Property, error) {
+	property, _, err := cdTektonPipeline.GetTektonPipelinePropertyWithContext(ctx, cdTektonPipeline.NewGetTektonPipelinePropertyOptions(pipelineID, name))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-property-error")
+	}
+	if propertyType := core.StringNilMapper(property.Type); propertyType != PropertyTypeSingleSelectConst {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("property %s is a %s property, not a single_select property", name, propertyType), "not-single-select", common.GetComponentInfo())
+	}
+	if err = change(property); err != nil {
+		return nil, err
+	}
+
+	options := cdTektonPipeline.NewReplaceTektonPipelinePropertyOptions(pipelineID, name, name, PropertyTypeSingleSelectConst).SetEnum(property.Enum)
+	options.Value, options.Locked, options.Path = property.Value, property.Locked, property.Path
+	if err = options.ValidateSingleSelect(); err != nil {
+		return nil, err
+	}
+	replaced, _, err := cdTektonPipeline.ReplaceTektonPipelinePropertyWithContext(ctx, options)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "replace-property-error")
+	}
+	return replaced, nil
+}
+
+// ValidateTektonPipelineRunOverrides checks the properties passed to a run: overrides of `single_select` properties
+// must be one of their options, and locked properties cannot be overridden. Trigger properties of the run's trigger
+// take precedence over pipeline properties. All problems are reported in a single error. CreateTektonPipelineRun does
+// not call it, so callers must call it before CreateTektonPipelineRun to enforce these rules.
+func (cdTektonPipeline *CdTektonPipelineV2) ValidateTektonPipelineRunOverrides(ctx context.Context, options *CreateTektonPipelineRunOptions) error {
+	if err := core.ValidateNotNil(options, "options cannot be nil"); err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	if err := core.ValidateNotNil(options.PipelineID, "options.PipelineID cannot be nil"); err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	pipelineID := *options.PipelineID
+	triggerName := core.StringNilMapper(options.TriggerName)
+	overrides := map[string]interface{}{}
+	for _, values := range []map[string]interface{}{options.TriggerProperties, options.SecureTriggerProperties} {
+		for name, value := range values {
+			overrides[name] = value
+		}
+	}
+	if trigger := options.Trigger; trigger != nil {
+		if triggerName == "" {
+			triggerName = core.StringNilMapper(trigger.Name)
+		}
+		for _, values := range []map[string]interface{}{trigger.Properties, trigger.SecureProperties} {
+			for name, value := range values {
+				overrides[name] = value
+			}
+		}
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	pipelineProperties, err := cdTektonPipeline.listProperties(ctx, pipelineID)
+	if err != nil {
+		return err
+	}
+	effective := map[string]Property{}
+	for _, property := range pipelineProperties {
+		effective[core.StringNilMapper(property.Name)] = property
+	}
+	if triggerName != "" {
+		trigger, err := cdTektonPipeline.findTrigger(ctx, pipelineID, triggerName)
+		if err != nil {
+			return err
+		}
+		for _, property := range trigger.Properties {
+			name := core.StringNilMapper(property.Name)
+			if pipelineProperty, ok := effective[name]; ok && isTrue(pipelineProperty.Locked) {
+				continue
+			}
+			effective[name] = Property{Name: property.Name, Value: property.Value, Enum: property.Enum, Type: property.Type, Locked: property.Locked}
+		}
+	}
+
+	var problems []string
+	for name, value := range overrides {
+		property, ok := effective[name]
+		if !ok {
+			continue
+		}
+		if isTrue(property.Locked) {
+			problems = append(problems, fmt.Sprintf("property %s is locked and cannot be overridden", name))
+			continue
+		}
+		if core.StringNilMapper(property.Type) != PropertyTypeSingleSelectConst {
+			continue
+		}
+		selected, isString := value.(string)
+		if !isString {
+			problems = append(problems, fmt.Sprintf("property %s must be overridden with a string", name))
+			continue
+		}
+		if !containsString(property.Enum, selected) {
+			problems = append(problems, fmt.Sprintf("property %s: the value %q is not one of the options %s", name, selected, quoteOptions(property.Enum)))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return core.SDKErrorf(nil, strings.Join(problems, "; "), "invalid-run-overrides", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// containsString returns true if values contains value.
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteOptions formats options for error messages.
+func quoteOptions(enum []string) string {
+	quoted := make([]string, len(enum))
+	for i, option := range enum {
+		quoted[i] = fmt.Sprintf("%q", option)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
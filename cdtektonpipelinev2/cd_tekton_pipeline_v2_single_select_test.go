@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Single select properties`, func() {
+	var testServer *httptest.Server
+	var region map[string]interface{}
+	var replaced int
+	BeforeEach(func() {
+		replaced = 0
+		region = map[string]interface{}{"name": "region", "type": "single_select", "value": "us-south", "enum": []string{"us-south", "eu-de"}, "locked": true}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1/properties/region":
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(region)).To(Succeed())
+			case "GET /tekton_pipelines/pipeline-1/properties/url":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"name": "url", "type": "text", "value": "https://example.com"}`)
+			case "PUT /tekton_pipelines/pipeline-1/properties/region":
+				replaced++
+				region = map[string]interface{}{}
+				Expect(json.NewDecoder(req.Body).Decode(&region)).To(Succeed())
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(region)).To(Succeed())
+			case "GET /tekton_pipelines/pipeline-1/properties":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"properties": [
+					{"name": "region", "type": "single_select", "value": "us-south", "enum": ["us-south", "eu-de"]},
+					{"name": "tier", "type": "single_select", "value": "small", "enum": ["small", "large"]},
+					{"name": "owner", "type": "text", "value": "ops", "locked": true}]}`)
+			case "GET /tekton_pipelines/pipeline-1/triggers":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"triggers": [{"id": "trigger-1", "name": "deploy", "type": "manual", "event_listener": "listener",
+					"properties": [{"name": "region", "type": "single_select", "value": "eu-de", "enum": ["eu-de", "eu-gb"]}]}]}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cdTektonPipelineService
+	}
+
+	Describe(`ValidateSingleSelect(enum, value)`, func() {
+		It(`Requires unique options that include the value`, func() {
+			Expect(cdtektonpipelinev2.ValidateSingleSelect([]string{"a", "b"}, "b")).To(Succeed())
+			err := cdtektonpipelinev2.ValidateSingleSelect([]string{"a", "b"}, "c")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`the value "c" is not one of the options ["a", "b"]`))
+			Expect(cdtektonpipelinev2.ValidateSingleSelect(nil, "a")).ToNot(Succeed())
+			Expect(cdtektonpipelinev2.ValidateSingleSelect([]string{"a", "a"}, "a")).ToNot(Succeed())
+		})
+		It(`Is available on the property create and replace options`, func() {
+			service := newService()
+			createOptions := service.NewCreateTektonPipelinePropertiesOptions("pipeline-1", "region", cdtektonpipelinev2.PropertyTypeSingleSelectConst).
+				SetEnum([]string{"us-south"}).SetValue("eu-de")
+			err := createOptions.ValidateSingleSelect()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`the value "eu-de" is not one of the options ["us-south"]`))
+			Expect(createOptions.SetValue("us-south").ValidateSingleSelect()).To(Succeed())
+
+			Expect(service.NewReplaceTektonPipelinePropertyOptions("pipeline-1", "region", "region", cdtektonpipelinev2.PropertyTypeSingleSelectConst).
+				SetEnum([]string{"us-south"}).SetValue("eu-de").ValidateSingleSelect()).ToNot(Succeed())
+			Expect(service.NewCreateTektonPipelineTriggerPropertiesOptions("pipeline-1", "trigger-1", "region", cdtektonpipelinev2.TriggerPropertyTypeSingleSelectConst).
+				SetEnum([]string{"us-south"}).SetValue("eu-de").ValidateSingleSelect()).ToNot(Succeed())
+			Expect(service.NewReplaceTektonPipelineTriggerPropertyOptions("pipeline-1", "trigger-1", "region", "region", cdtektonpipelinev2.TriggerPropertyTypeSingleSelectConst).
+				SetEnum([]string{"us-south"}).SetValue("eu-de").ValidateSingleSelect()).ToNot(Succeed())
+			Expect(service.NewReplaceTektonPipelineTriggerPropertyOptions("pipeline-1", "trigger-1", "region", "region", cdtektonpipelinev2.TriggerPropertyTypeTextConst).
+				SetValue("eu-de").ValidateSingleSelect()).To(Succeed())
+		})
+	})
+	Describe(`AddSingleSelectOptions(ctx, pipelineID, name, options...)`, func() {
+		It(`Adds new options and keeps the value and lock`, func() {
+			property, err := newService().AddSingleSelectOptions(context.Background(), "pipeline-1", "region", "eu-gb", "eu-de")
+			Expect(err).To(BeNil())
+			Expect(property.Enum).To(Equal([]string{"us-south", "eu-de", "eu-gb"}))
+			Expect(*property.Value).To(Equal("us-south"))
+			Expect(*property.Locked).To(BeTrue())
+		})
+		It(`Rejects properties that are not single_select`, func() {
+			_, err := newService().AddSingleSelectOptions(context.Background(), "pipeline-1", "url", "a")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("url is a text property"))
+		})
+	})
+
+	Describe(`RemoveSingleSelectOptions(ctx, pipelineID, name, options...)`, func() {
+		It(`Removes options`, func() {
+			property, err := newService().RemoveSingleSelectOptions(context.Background(), "pipeline-1", "region", "eu-de")
+			Expect(err).To(BeNil())
+			Expect(property.Enum).To(Equal([]string{"us-south"}))
+			Expect(*property.Locked).To(BeTrue())
+		})
+		It(`Refuses to remove the selected value`, func() {
+			_, err := newService().RemoveSingleSelectOptions(context.Background(), "pipeline-1", "region", "us-south")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`cannot remove the option "us-south" of property region because it is the selected value`))
+			Expect(replaced).To(BeZero())
+		})
+	})
+
+	Describe(`SelectSingleSelectValue(ctx, pipelineID, name, value)`, func() {
+		It(`Changes the selected value`, func() {
+			property, err := newService().SelectSingleSelectValue(context.Background(), "pipeline-1", "region", "eu-de")
+			Expect(err).To(BeNil())
+			Expect(*property.Value).To(Equal("eu-de"))
+			Expect(*property.Locked).To(BeTrue())
+		})
+		It(`Rejects values that are not options`, func() {
+			_, err := newService().SelectSingleSelectValue(context.Background(), "pipeline-1", "region", "ap-north")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`the value "ap-north" is not one of the options`))
+			Expect(replaced).To(BeZero())
+		})
+	})
+
+	Describe(`ValidateTektonPipelineRunOverrides(ctx, options)`, func() {
+		It(`Accepts valid overrides, using the options of the trigger properties`, func() {
+			service := newService()
+			options := service.NewCreateTektonPipelineRunOptions("pipeline-1").SetTriggerName("deploy").
+				SetTriggerProperties(map[string]interface{}{"region": "eu-gb", "tier": "large", "other": 1})
+			Expect(service.ValidateTektonPipelineRunOverrides(context.Background(), options)).To(Succeed())
+		})
+		It(`Reports every invalid override`, func() {
+			service := newService()
+			options := service.NewCreateTektonPipelineRunOptions("pipeline-1").
+				SetTriggerProperties(map[string]interface{}{"region": "eu-gb", "tier": 2, "owner": "dev"})
+			err := service.ValidateTektonPipelineRunOverrides(context.Background(), options)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal(`property owner is locked and cannot be overridden; ` +
+				`property region: the value "eu-gb" is not one of the options ["us-south", "eu-de"]; ` +
+				`property tier must be overridden with a string`))
+		})
+	})
+})
@@ -0,0 +1,558 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Constants associated with the PropertySource.Format property.
+// The format of a property source.
+const (
+	PropertySourceFormatDotenvConst = "dotenv"
+	PropertySourceFormatYAMLConst   = "yaml"
+)
+
+// Constants associated with the PropertySyncChange.Action property.
+// The action taken for a property.
+const (
+	PropertySyncActionCreateConst    = "create"
+	PropertySyncActionReplaceConst   = "replace"
+	PropertySyncActionDeleteConst    = "delete"
+	PropertySyncActionUnchangedConst = "unchanged"
+	PropertySyncActionSkippedConst   = "skipped"
+)
+
+// PropertySource : A file holding the desired properties of a pipeline or trigger.
+//
+// A dotenv file holds one NAME=value entry per line. A YAML file holds either a mapping of names to values, where a
+// value may also be a mapping with the value, type, enum, locked and path fields of a property, or one or more
+// Kubernetes ConfigMap and Secret manifests. The entries of a Secret become secure properties.
+type PropertySource struct {
+	// The name of the file. It is read when Data is empty, and it is used to detect the format.
+	Name string
+
+	// The content of the file.
+	Data []byte
+
+	// The format of the file. When empty, files named .env, .env.* or *.env are read as dotenv and all others as YAML.
+	Format string
+
+	// The names of entries that become secure properties.
+	SecureNames []string
+}
+
+// PropertySyncOptions : Options for SyncTektonPipelineProperties.
+type PropertySyncOptions struct {
+	// Sync the properties of the trigger with this name instead of the pipeline properties.
+	TriggerName string
+
+	// Report the changes without making them.
+	DryRun bool
+
+	// Delete the properties that are not in the source.
+	Prune bool
+
+	// Replace and delete locked properties, which are otherwise skipped.
+	OverrideLocked bool
+}
+
+// PropertySyncChange : The change made to a single property by SyncTektonPipelineProperties.
+type PropertySyncChange struct {
+	// The name of the property.
+	Name string `json:"name"`
+
+	// The action taken for the property.
+	Action string `json:"action"`
+
+	// The type of the property.
+	Type string `json:"type"`
+
+	// Why the property was skipped.
+	Reason string `json:"reason,omitempty"`
+
+	// The error returned by the property call, if any.
+	Error error `json:"-"`
+}
+
+// PropertySyncReport : The outcome of SyncTektonPipelineProperties.
+type PropertySyncReport struct {
+	// Whether the changes were only reported.
+	DryRun bool `json:"dry_run"`
+
+	// The ID of the trigger whose properties were synced, if any.
+	TriggerID string `json:"trigger_id,omitempty"`
+
+	// The change for each property, sorted by name.
+	Changes []PropertySyncChange `json:"changes"`
+}
+
+// propertyTarget : The property calls of a pipeline or of one of its triggers.
+type propertyTarget struct {
+	list    func() ([]Property, error)
+	create  func(property Property) error
+	replace func(property Property) error
+	delete  func(name string) error
+}
+
+// SyncTektonPipelineProperties makes the properties of a pipeline, or of one of its triggers, match a property
+// source. Properties missing from the pipeline are created and those that differ are replaced; properties missing
+// from the source are deleted when pruning. Locked properties are skipped unless the options override them, and so
+// are trigger properties that would override a locked pipeline property. Secure values cannot be read back, so secure
+// properties in the source are always replaced. A failing property call does not stop the others; the report holds
+// the change for each property and an error is returned if any of them failed.
+func (cdTektonPipeline *CdTektonPipelineV2) SyncTektonPipelineProperties(ctx context.Context, pipelineID string, source *PropertySource, opts *PropertySyncOptions) (report *PropertySyncReport, err error) {
+	if err = core.ValidateNotNil(source, "source cannot be nil"); err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if opts == nil {
+		opts = &PropertySyncOptions{}
+	}
+	desired, err := source.Properties()
+	if err != nil {
+		return
+	}
+
+	report = &PropertySyncReport{DryRun: opts.DryRun, Changes: []PropertySyncChange{}}
+	target, lockedByPipeline, err := cdTektonPipeline.propertyTarget(ctx, pipelineID, opts.TriggerName, report)
+	if err != nil {
+		report = nil
+		return
+	}
+	current, err := target.list()
+	if err != nil {
+		report = nil
+		return
+	}
+
+	existing := map[string]Property{}
+	for _, property := range current {
+		existing[core.StringNilMapper(property.Name)] = property
+	}
+	wanted := map[string]bool{}
+	failed := 0
+	apply := func(change PropertySyncChange, call func() error) {
+		if !opts.DryRun {
+			if change.Error = call(); change.Error != nil {
+				failed++
+			}
+		}
+		report.Changes = append(report.Changes, change)
+	}
+
+	for _, property := range desired {
+		name := *property.Name
+		wanted[name] = true
+		change := PropertySyncChange{Name: name, Type: *property.Type}
+		old, exists := existing[name]
+		switch {
+		case lockedByPipeline[name]:
+			change.Action, change.Reason = PropertySyncActionSkippedConst, "the pipeline property is locked"
+		case !exists:
+			change.Action = PropertySyncActionCreateConst
+			apply(change, func() error { return target.create(property) })
+			continue
+		case isTrue(old.Locked) && !opts.OverrideLocked:
+			change.Action, change.Reason = PropertySyncActionSkippedConst, "the property is locked"
+		default:
+			if property.Locked == nil {
+				property.Locked = old.Locked
+			}
+			if propertyUnchanged(old, property) {
+				change.Action = PropertySyncActionUnchangedConst
+				break
+			}
+			change.Action = PropertySyncActionReplaceConst
+			apply(change, func() error { return target.replace(property) })
+			continue
+		}
+		report.Changes = append(report.Changes, change)
+	}
+
+	if opts.Prune {
+		for _, property := range current {
+			name := core.StringNilMapper(property.Name)
+			if wanted[name] {
+				continue
+			}
+			change := PropertySyncChange{Name: name, Type: core.StringNilMapper(property.Type), Action: PropertySyncActionDeleteConst}
+			if isTrue(property.Locked) && !opts.OverrideLocked {
+				change.Action, change.Reason = PropertySyncActionSkippedConst, "the property is locked"
+				report.Changes = append(report.Changes, change)
+				continue
+			}
+			apply(change, func() error { return target.delete(name) })
+		}
+	}
+
+	sort.SliceStable(report.Changes, func(i, j int) bool {
+		return report.Changes[i].Name < report.Changes[j].Name
+	})
+	if failed > 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%d of %d properties could not be synced", failed, len(report.Changes)), "sync-properties-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// propertyTarget returns the property calls of the pipeline, or of the named trigger together with the names of the
+// locked pipeline properties that its properties must not override.
+func (cdTektonPipeline *CdTektonPipelineV2) propertyTarget(ctx context.Context, pipelineID string, triggerName string, report *PropertySyncReport) (target *propertyTarget, lockedByPipeline map[string]bool, err error) {
+	if triggerName == "" {
+		target = &propertyTarget{
+			list: func() ([]Property, error) {
+				return cdTektonPipeline.listProperties(ctx, pipelineID)
+			},
+			create: func(property Property) error {
+				options := cdTektonPipeline.NewCreateTektonPipelinePropertiesOptions(pipelineID, *property.Name, *property.Type)
+				options.Value, options.Enum, options.Locked, options.Path = property.Value, property.Enum, property.Locked, property.Path
+				_, _, err := cdTektonPipeline.CreateTektonPipelinePropertiesWithContext(ctx, options)
+				return err
+			},
+			replace: func(property Property) error {
+				options := cdTektonPipeline.NewReplaceTektonPipelinePropertyOptions(pipelineID, *property.Name, *property.Name, *property.Type)
+				options.Value, options.Enum, options.Locked, options.Path = property.Value, property.Enum, property.Locked, property.Path
+				_, _, err := cdTektonPipeline.ReplaceTektonPipelinePropertyWithContext(ctx, options)
+				return err
+			},
+			delete: func(name string) error {
+				_, err := cdTektonPipeline.DeleteTektonPipelinePropertyWithContext(ctx, cdTektonPipeline.NewDeleteTektonPipelinePropertyOptions(pipelineID, name))
+				return err
+			},
+		}
+		return
+	}
+
+	trigger, err := cdTektonPipeline.findTrigger(ctx, pipelineID, triggerName)
+	if err != nil {
+		return
+	}
+	triggerID := core.StringNilMapper(trigger.ID)
+	report.TriggerID = triggerID
+	pipelineProperties, err := cdTektonPipeline.listProperties(ctx, pipelineID)
+	if err != nil {
+		return
+	}
+	lockedByPipeline = map[string]bool{}
+	for _, property := range pipelineProperties {
+		if isTrue(property.Locked) {
+			lockedByPipeline[core.StringNilMapper(property.Name)] = true
+		}
+	}
+
+	target = &propertyTarget{
+		list: func() ([]Property, error) {
+			properties, _, err := cdTektonPipeline.ListTektonPipelineTriggerPropertiesWithContext(ctx, cdTektonPipeline.NewListTektonPipelineTriggerPropertiesOptions(pipelineID, triggerID))
+			if err != nil {
+				return nil, core.RepurposeSDKProblem(err, "list-trigger-properties-error")
+			}
+			converted := make([]Property, 0, len(properties.Properties))
+			for _, property := range properties.Properties {
+				converted = append(converted, Property{
+					Name:   property.Name,
+					Value:  property.Value,
+					Enum:   property.Enum,
+					Type:   property.Type,
+					Locked: property.Locked,
+					Path:   property.Path,
+				})
+			}
+			return converted, nil
+		},
+		create: func(property Property) error {
+			options := cdTektonPipeline.NewCreateTektonPipelineTriggerPropertiesOptions(pipelineID, triggerID, *property.Name, *property.Type)
+			options.Value, options.Enum, options.Locked, options.Path = property.Value, property.Enum, property.Locked, property.Path
+			_, _, err := cdTektonPipeline.CreateTektonPipelineTriggerPropertiesWithContext(ctx, options)
+			return err
+		},
+		replace: func(property Property) error {
+			options := cdTektonPipeline.NewReplaceTektonPipelineTriggerPropertyOptions(pipelineID, triggerID, *property.Name, *property.Name, *property.Type)
+			options.Value, options.Enum, options.Locked, options.Path = property.Value, property.Enum, property.Locked, property.Path
+			_, _, err := cdTektonPipeline.ReplaceTektonPipelineTriggerPropertyWithContext(ctx, options)
+			return err
+		},
+		delete: func(name string) error {
+			_, err := cdTektonPipeline.DeleteTektonPipelineTriggerPropertyWithContext(ctx, cdTektonPipeline.NewDeleteTektonPipelineTriggerPropertyOptions(pipelineID, triggerID, name))
+			return err
+		},
+	}
+	return
+}
+
+// listProperties returns the properties of a pipeline.
+func (cdTektonPipeline *CdTektonPipelineV2) listProperties(ctx context.Context, pipelineID string) ([]Property, error) {
+	properties, _, err := cdTektonPipeline.ListTektonPipelinePropertiesWithContext(ctx, cdTektonPipeline.NewListTektonPipelinePropertiesOptions(pipelineID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-properties-error")
+	}
+	return properties.Properties, nil
+}
+
+// propertyUnchanged returns true if replacing a property with the desired one would not change it.
+func propertyUnchanged(current Property, desired Property) bool {
+	if *desired.Type == PropertyTypeSecureConst {
+		return false
+	}
+	return core.StringNilMapper(current.Type) == *desired.Type &&
+		core.StringNilMapper(current.Value) == core.StringNilMapper(desired.Value) &&
+		isTrue(current.Locked) == isTrue(desired.Locked) &&
+		core.StringNilMapper(current.Path) == core.StringNilMapper(desired.Path) &&
+		strings.Join(current.Enum, "\x00") == strings.Join(desired.Enum, "\x00")
+}
+
+// Properties reads the properties of the source, sorted by name.
+func (source *PropertySource) Properties() (properties []Property, err error) {
+	data := source.Data
+	if len(data) == 0 {
+		if data, err = os.ReadFile(source.Name); err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("could not read property source %s: %s", source.Name, err.Error()), "read-property-source-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	format := source.Format
+	if format == "" {
+		base := filepath.Base(source.Name)
+		format = PropertySourceFormatYAMLConst
+		if base == ".env" || strings.HasPrefix(base, ".env.") || strings.HasSuffix(base, ".env") {
+			format = PropertySourceFormatDotenvConst
+		}
+	}
+	switch format {
+	case PropertySourceFormatDotenvConst:
+		properties, err = parseDotenv(data)
+	case PropertySourceFormatYAMLConst:
+		properties, err = parsePropertyYAML(data)
+	default:
+		err = fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("could not parse property source %s: %s", source.Name, err.Error()), "parse-property-source-error", common.GetComponentInfo())
+		return
+	}
+
+	seen := map[string]bool{}
+	for i := range properties {
+		name := *properties[i].Name
+		if seen[name] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("property %s is defined more than once in %s", name, source.Name), "duplicate-property", common.GetComponentInfo())
+			return
+		}
+		seen[name] = true
+		if len(source.SecureNames) > 0 && matchesAny(source.SecureNames, name) {
+			properties[i].Type = core.StringPtr(PropertyTypeSecureConst)
+		}
+	}
+	sort.Slice(properties, func(i, j int) bool {
+		return *properties[i].Name < *properties[j].Name
+	})
+	return
+}
+
+// parseDotenv reads NAME=value lines. Values may be single quoted, taken literally, or double quoted, with \n, \t, \"
+// and \\ escapes. Unquoted values end at a " #" comment.
+func parseDotenv(data []byte) (properties []Property, err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		name, value, found := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("line %d: expected NAME=value", number)
+		}
+		value = strings.TrimSpace(value)
+		switch {
+		case strings.HasPrefix(value, "'"):
+			end := strings.Index(value[1:], "'")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated quoted value", number)
+			}
+			value = value[1 : end+1]
+		case strings.HasPrefix(value, `"`):
+			var unquoted strings.Builder
+			closed := false
+			for i := 1; i < len(value) && !closed; i++ {
+				switch c := value[i]; {
+				case c == '"':
+					closed = true
+				case c == '\\' && i+1 < len(value):
+					i++
+					switch value[i] {
+					case 'n':
+						unquoted.WriteByte('\n')
+					case 't':
+						unquoted.WriteByte('\t')
+					default:
+						unquoted.WriteByte(value[i])
+					}
+				default:
+					unquoted.WriteByte(c)
+				}
+			}
+			if !closed {
+				return nil, fmt.Errorf("line %d: unterminated quoted value", number)
+			}
+			value = unquoted.String()
+		default:
+			if comment := strings.Index(value, " #"); comment >= 0 {
+				value = strings.TrimSpace(value[:comment])
+			}
+		}
+		properties = append(properties, Property{
+			Name:  core.StringPtr(name),
+			Value: core.StringPtr(value),
+			Type:  core.StringPtr(PropertyTypeTextConst),
+		})
+	}
+	return properties, scanner.Err()
+}
+
+// propertyEntry : A property given as a mapping in a YAML property source.
+type propertyEntry struct {
+	Value  interface{} `yaml:"value"`
+	Type   string      `yaml:"type"`
+	Enum   []string    `yaml:"enum"`
+	Locked *bool       `yaml:"locked"`
+	Path   *string     `yaml:"path"`
+}
+
+// kubernetesManifest : The parts of a ConfigMap or Secret manifest that hold properties.
+type kubernetesManifest struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Data       map[string]string `yaml:"data"`
+	StringData map[string]string `yaml:"stringData"`
+}
+
+// parsePropertyYAML reads the documents of a YAML property source.
+func parsePropertyYAML(data []byte) (properties []Property, err error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for number := 1; ; number++ {
+		var document yaml.Node
+		if err = decoder.Decode(&document); errors.Is(err, io.EOF) {
+			return properties, nil
+		} else if err != nil {
+			return nil, err
+		}
+		var manifest kubernetesManifest
+		if err = document.Decode(&manifest); err == nil && manifest.APIVersion != "" && manifest.Kind != "" {
+			var parsed []Property
+			if parsed, err = manifestProperties(manifest); err != nil {
+				return nil, fmt.Errorf("document %d: %s", number, err.Error())
+			}
+			properties = append(properties, parsed...)
+			continue
+		}
+		var entries map[string]yaml.Node
+		if err = document.Decode(&entries); err != nil {
+			return nil, fmt.Errorf("document %d: expected a mapping of property names", number)
+		}
+		for name, node := range entries {
+			property, entryErr := entryProperty(name, &node)
+			if entryErr != nil {
+				return nil, fmt.Errorf("document %d: property %s: %s", number, name, entryErr.Error())
+			}
+			properties = append(properties, property)
+		}
+	}
+}
+
+// manifestProperties returns the entries of a ConfigMap as text properties and those of a Secret as secure
+// properties.
+func manifestProperties(manifest kubernetesManifest) (properties []Property, err error) {
+	propertyType := PropertyTypeTextConst
+	switch manifest.Kind {
+	case "ConfigMap":
+	case "Secret":
+		propertyType = PropertyTypeSecureConst
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", manifest.Kind)
+	}
+	values := map[string]string{}
+	for name, value := range manifest.Data {
+		if propertyType == PropertyTypeSecureConst {
+			decoded, decodeErr := base64.StdEncoding.DecodeString(value)
+			if decodeErr != nil {
+				return nil, fmt.Errorf("entry %s is not base64 encoded", name)
+			}
+			value = string(decoded)
+		}
+		values[name] = value
+	}
+	for name, value := range manifest.StringData {
+		values[name] = value
+	}
+	for name, value := range values {
+		properties = append(properties, Property{
+			Name:  core.StringPtr(name),
+			Value: core.StringPtr(value),
+			Type:  core.StringPtr(propertyType),
+		})
+	}
+	return
+}
+
+// entryProperty returns the property for a YAML entry, which is either a scalar value or a mapping of property
+// fields.
+func entryProperty(name string, node *yaml.Node) (property Property, err error) {
+	property = Property{Name: core.StringPtr(name), Type: core.StringPtr(PropertyTypeTextConst)}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		property.Value = core.StringPtr(node.Value)
+		if node.Tag == "!!null" {
+			property.Value = core.StringPtr("")
+		}
+	case yaml.MappingNode:
+		var entry propertyEntry
+		if err = node.Decode(&entry); err != nil {
+			return
+		}
+		if entry.Type != "" {
+			property.Type = core.StringPtr(entry.Type)
+		}
+		if entry.Value != nil {
+			property.Value = core.StringPtr(fmt.Sprint(entry.Value))
+		}
+		property.Enum, property.Locked, property.Path = entry.Enum, entry.Locked, entry.Path
+	default:
+		err = errors.New("expected a value or a mapping of property fields")
+	}
+	return
+}
+
+// isTrue returns true if value is set and true.
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Property sync`, func() {
+	Describe(`PropertySource.Properties()`, func() {
+		It(`Reads dotenv files`, func() {
+			source := &cdtektonpipelinev2.PropertySource{
+				Name:        "config/.env",
+				Data:        []byte("# settings\nexport REGION=us-south # default region\nGREETING=\"hello\\nworld\"\nRAW='a \"b\" #c'\nAPI_KEY=abc123\n"),
+				SecureNames: []string{"API_KEY"},
+			}
+			properties, err := source.Properties()
+			Expect(err).To(BeNil())
+			Expect(properties).To(HaveLen(4))
+			Expect(*properties[0].Name).To(Equal("API_KEY"))
+			Expect(*properties[0].Type).To(Equal(cdtektonpipelinev2.PropertyTypeSecureConst))
+			Expect(*properties[1].Value).To(Equal("hello\nworld"))
+			Expect(*properties[2].Value).To(Equal(`a "b" #c`))
+			Expect(*properties[3].Name).To(Equal("REGION"))
+			Expect(*properties[3].Value).To(Equal("us-south"))
+			Expect(*properties[3].Type).To(Equal(cdtektonpipelinev2.PropertyTypeTextConst))
+		})
+		It(`Reads YAML mappings with scalar and structured entries`, func() {
+			properties, err := (&cdtektonpipelinev2.PropertySource{Name: "properties.yaml", Data: []byte(`
+replicas: 3
+empty:
+region:
+  value: us-south
+  type: single_select
+  enum: [us-south, eu-de]
+  locked: true
+`)}).Properties()
+			Expect(err).To(BeNil())
+			Expect(properties).To(HaveLen(3))
+			Expect(*properties[0].Value).To(Equal(""))
+			Expect(*properties[1].Type).To(Equal(cdtektonpipelinev2.PropertyTypeSingleSelectConst))
+			Expect(properties[1].Enum).To(Equal([]string{"us-south", "eu-de"}))
+			Expect(*properties[1].Locked).To(BeTrue())
+			Expect(*properties[2].Value).To(Equal("3"))
+		})
+		It(`Reads ConfigMap and Secret manifests`, func() {
+			dir, err := os.MkdirTemp("", "property-sync")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			file := filepath.Join(dir, "env.yaml")
+			Expect(os.WriteFile(file, []byte(`apiVersion: v1
+kind: ConfigMap
+data:
+  region: us-south
+---
+apiVersion: v1
+kind: Secret
+data:
+  token: c2VjcmV0
+stringData:
+  password: hunter2
+`), 0o600)).To(Succeed())
+			properties, err := (&cdtektonpipelinev2.PropertySource{Name: file}).Properties()
+			Expect(err).To(BeNil())
+			Expect(properties).To(HaveLen(3))
+			Expect(*properties[0].Name).To(Equal("password"))
+			Expect(*properties[0].Type).To(Equal(cdtektonpipelinev2.PropertyTypeSecureConst))
+			Expect(*properties[1].Type).To(Equal(cdtektonpipelinev2.PropertyTypeTextConst))
+			Expect(*properties[2].Value).To(Equal("secret"))
+		})
+		It(`Rejects duplicate and malformed entries`, func() {
+			_, err := (&cdtektonpipelinev2.PropertySource{Name: ".env", Data: []byte("A=1\nA=2\n")}).Properties()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("property A is defined more than once"))
+			_, err = (&cdtektonpipelinev2.PropertySource{Name: ".env", Data: []byte("A=1\nnot a property\n")}).Properties()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("line 2"))
+			_, err = (&cdtektonpipelinev2.PropertySource{Name: "p.yaml", Data: []byte("a: [1, 2]\n")}).Properties()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`SyncTektonPipelineProperties(ctx, pipelineID, source, opts)`, func() {
+		var testServer *httptest.Server
+		var properties map[string]map[string]map[string]interface{}
+		var calls []string
+		BeforeEach(func() {
+			calls = nil
+			properties = map[string]map[string]map[string]interface{}{
+				"/tekton_pipelines/pipeline-1/properties": {
+					"region":   {"name": "region", "type": "text", "value": "eu-de"},
+					"replicas": {"name": "replicas", "type": "text", "value": "3"},
+					"stale":    {"name": "stale", "type": "text", "value": "x"},
+					"owner":    {"name": "owner", "type": "text", "value": "ops", "locked": true},
+				},
+				"/tekton_pipelines/pipeline-1/triggers/trigger-1/properties": {
+					"replicas": {"name": "replicas", "type": "text", "value": "1"},
+				},
+			}
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				path := req.URL.EscapedPath()
+				if req.Method == "GET" && path == "/tekton_pipelines/pipeline-1/triggers" {
+					res.WriteHeader(200)
+					Expect(json.NewEncoder(res).Encode(map[string]interface{}{"triggers": []map[string]interface{}{
+						{"id": "trigger-1", "name": "nightly", "type": "manual", "event_listener": "listener"},
+					}})).To(Succeed())
+					return
+				}
+				collection, name := path, ""
+				if _, ok := properties[path]; !ok {
+					collection, name = path[:strings.LastIndex(path, "/")], path[strings.LastIndex(path, "/")+1:]
+				}
+				Expect(properties).To(HaveKey(collection))
+				if req.Method != "GET" {
+					calls = append(calls, req.Method+" "+strings.TrimPrefix(path, "/tekton_pipelines/pipeline-1/"))
+				}
+				switch req.Method {
+				case "GET":
+					list := []map[string]interface{}{}
+					for _, property := range properties[collection] {
+						list = append(list, property)
+					}
+					res.WriteHeader(200)
+					Expect(json.NewEncoder(res).Encode(map[string]interface{}{"properties": list})).To(Succeed())
+				case "POST", "PUT":
+					var property map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&property)).To(Succeed())
+					properties[collection][property["name"].(string)] = property
+					res.WriteHeader(200)
+					Expect(json.NewEncoder(res).Encode(property)).To(Succeed())
+				case "DELETE":
+					delete(properties[collection], name)
+					res.WriteHeader(204)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+			cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			return cdTektonPipelineService
+		}
+		actions := func(report *cdtektonpipelinev2.PropertySyncReport) map[string]string {
+			result := map[string]string{}
+			for _, change := range report.Changes {
+				result[change.Name] = change.Action
+			}
+			return result
+		}
+		source := &cdtektonpipelinev2.PropertySource{
+			Name:        ".env",
+			Data:        []byte("region=us-south\nreplicas=3\nowner=dev\nAPI_KEY=abc\n"),
+			SecureNames: []string{"API_KEY"},
+		}
+
+		It(`Reports the changes of a dry run without making them`, func() {
+			report, err := newService().SyncTektonPipelineProperties(context.Background(), "pipeline-1", source, &cdtektonpipelinev2.PropertySyncOptions{DryRun: true, Prune: true})
+			Expect(err).To(BeNil())
+			Expect(report.DryRun).To(BeTrue())
+			Expect(actions(report)).To(Equal(map[string]string{
+				"API_KEY":  cdtektonpipelinev2.PropertySyncActionCreateConst,
+				"owner":    cdtektonpipelinev2.PropertySyncActionSkippedConst,
+				"region":   cdtektonpipelinev2.PropertySyncActionReplaceConst,
+				"replicas": cdtektonpipelinev2.PropertySyncActionUnchangedConst,
+				"stale":    cdtektonpipelinev2.PropertySyncActionDeleteConst,
+			}))
+			Expect(calls).To(BeEmpty())
+		})
+		It(`Creates, replaces and prunes pipeline properties`, func() {
+			report, err := newService().SyncTektonPipelineProperties(context.Background(), "pipeline-1", source, &cdtektonpipelinev2.PropertySyncOptions{Prune: true})
+			Expect(err).To(BeNil())
+			Expect(report.Changes).To(HaveLen(5))
+			sort.Strings(calls)
+			Expect(calls).To(Equal([]string{"DELETE properties/stale", "POST properties", "PUT properties/region"}))
+			pipelineProperties := properties["/tekton_pipelines/pipeline-1/properties"]
+			Expect(pipelineProperties["API_KEY"]).To(HaveKeyWithValue("type", "secure"))
+			Expect(pipelineProperties["region"]).To(HaveKeyWithValue("value", "us-south"))
+			Expect(pipelineProperties["owner"]).To(HaveKeyWithValue("value", "ops"))
+		})
+		It(`Replaces locked properties when told to`, func() {
+			_, err := newService().SyncTektonPipelineProperties(context.Background(), "pipeline-1", source, &cdtektonpipelinev2.PropertySyncOptions{OverrideLocked: true})
+			Expect(err).To(BeNil())
+			owner := properties["/tekton_pipelines/pipeline-1/properties"]["owner"]
+			Expect(owner).To(HaveKeyWithValue("value", "dev"))
+			Expect(owner).To(HaveKeyWithValue("locked", true))
+		})
+		It(`Syncs trigger properties without overriding locked pipeline properties`, func() {
+			report, err := newService().SyncTektonPipelineProperties(context.Background(), "pipeline-1", source, &cdtektonpipelinev2.PropertySyncOptions{TriggerName: "nightly", Prune: true})
+			Expect(err).To(BeNil())
+			Expect(report.TriggerID).To(Equal("trigger-1"))
+			Expect(actions(report)).To(Equal(map[string]string{
+				"API_KEY":  cdtektonpipelinev2.PropertySyncActionCreateConst,
+				"owner":    cdtektonpipelinev2.PropertySyncActionSkippedConst,
+				"region":   cdtektonpipelinev2.PropertySyncActionCreateConst,
+				"replicas": cdtektonpipelinev2.PropertySyncActionReplaceConst,
+			}))
+			Expect(properties["/tekton_pipelines/pipeline-1/triggers/trigger-1/properties"]).To(HaveLen(3))
+			Expect(properties["/tekton_pipelines/pipeline-1/properties"]["region"]).To(HaveKeyWithValue("value", "eu-de"))
+		})
+	})
+})
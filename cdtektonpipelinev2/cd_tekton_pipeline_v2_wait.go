@@ -0,0 +1,83 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultWaitPollInterval is the interval between two polls of a pipeline run when WaitOptions.PollInterval is not
+// set.
+const DefaultWaitPollInterval = 10 * time.Second
+
+// WaitOptions : Options for waiting on a pipeline run.
+type WaitOptions struct {
+	// Time between two polls of the run.
+	PollInterval time.Duration
+
+	// Called every time the run is observed in a new status, including the first observation, where previousStatus
+	// is "".
+	OnTransition func(run *PipelineRun, previousStatus string)
+}
+
+// WaitForTektonPipelineRun polls a pipeline run until it reaches a terminal status and returns it. Waiting stops with
+// an error when ctx is done; the last observed run is returned along with the error.
+func (cdTektonPipeline *CdTektonPipelineV2) WaitForTektonPipelineRun(ctx context.Context, pipelineID string, runID string, options *WaitOptions) (run *PipelineRun, err error) {
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	pollInterval := options.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultWaitPollInterval
+	}
+	getOptions := cdTektonPipeline.NewGetTektonPipelineRunOptions(pipelineID, runID)
+
+	previousStatus := ""
+	for {
+		var current *PipelineRun
+		current, _, err = cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, getOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "wait-get-run-error")
+			return
+		}
+		run = current
+		status := ""
+		if run.Status != nil {
+			status = *run.Status
+		}
+		if status != previousStatus && options.OnTransition != nil {
+			options.OnTransition(run, previousStatus)
+		}
+		previousStatus = status
+		if IsTerminalPipelineRunStatus(status) {
+			return
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = core.SDKErrorf(ctx.Err(), "", "wait-cancelled", common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+	}
+}
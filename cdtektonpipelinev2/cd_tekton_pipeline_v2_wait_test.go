@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForTektonPipelineRun(ctx, pipelineID, runID, options)`, func() {
+	var testServer *httptest.Server
+	var statuses []string
+	BeforeEach(func() {
+		statuses = []string{"queued", "running", "running", "succeeded"}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/tekton_pipelines/pipeline-1/pipeline_runs/run-1"))
+			Expect(req.Method).To(Equal("GET"))
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "run-1", "status": "%s"}`, status)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Waits until the run is finished`, func() {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		var transitions []string
+		run, err := cdTektonPipelineService.WaitForTektonPipelineRun(context.Background(), "pipeline-1", "run-1", &cdtektonpipelinev2.WaitOptions{
+			PollInterval: time.Millisecond,
+			OnTransition: func(run *cdtektonpipelinev2.PipelineRun, previousStatus string) {
+				transitions = append(transitions, previousStatus+"->"+*run.Status)
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(*run.Status).To(Equal("succeeded"))
+		Expect(transitions).To(Equal([]string{"->queued", "queued->running", "running->succeeded"}))
+	})
+	It(`Stops when the context is done`, func() {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		statuses = []string{"running"}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		run, err := cdTektonPipelineService.WaitForTektonPipelineRun(ctx, "pipeline-1", "run-1", &cdtektonpipelinev2.WaitOptions{PollInterval: 10 * time.Millisecond})
+		Expect(err).ToNot(BeNil())
+		Expect(*run.Status).To(Equal("running"))
+	})
+})
@@ -0,0 +1,207 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package definitions
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Issue.Kind property.
+const (
+	IssueKindUnknownListenerConst  = "unknown-listener"
+	IssueKindUnknownBindingConst   = "unknown-binding"
+	IssueKindUnknownTemplateConst  = "unknown-template"
+	IssueKindUnknownPipelineConst  = "unknown-pipeline"
+	IssueKindUnknownTaskConst      = "unknown-task"
+	IssueKindUnmatchedParamConst   = "unmatched-param"
+	IssueKindUnusedDefinitionConst = "unused-definition"
+)
+
+// Issue : A problem found by Check.
+type Issue struct {
+	// The kind of the issue.
+	Kind string `json:"kind"`
+
+	// The name of the pipeline trigger the issue was found for, if any.
+	Trigger string `json:"trigger,omitempty"`
+
+	// The ID of the definition the issue was found in, if any.
+	DefinitionID string `json:"definition_id,omitempty"`
+
+	// The file the issue was found in, if any.
+	File string `json:"file,omitempty"`
+
+	// A description of the issue.
+	Message string `json:"message"`
+}
+
+// CheckReport : The outcome of Check.
+type CheckReport struct {
+	// The issues found, sorted by kind, trigger and message.
+	Issues []Issue `json:"issues"`
+}
+
+// OK returns true if no issues were found.
+func (report *CheckReport) OK() bool {
+	return len(report.Issues) == 0
+}
+
+// Check cross-checks the triggers and properties of a pipeline against the repository. It reports triggers whose
+// event listener does not exist, references to bindings, templates, pipelines and tasks that do not exist, trigger
+// template parameters without a default that are set neither by a binding nor by a pipeline or trigger property,
+// and definitions none of whose documents are used by any trigger.
+func (repository *Repository) Check(pipeline *cdtektonpipelinev2.TektonPipeline) *CheckReport {
+	report := &CheckReport{Issues: []Issue{}}
+	used := map[string]bool{}
+	use := func(document Document) {
+		used[document.DefinitionID] = true
+	}
+
+	pipelineProperties := map[string]bool{}
+	if pipeline != nil {
+		for _, property := range pipeline.Properties {
+			pipelineProperties[core.StringNilMapper(property.Name)] = true
+		}
+	}
+	var triggers []*cdtektonpipelinev2.Trigger
+	if pipeline != nil {
+		for _, triggerIntf := range pipeline.Triggers {
+			if trigger := cdtektonpipelinev2.AsTrigger(triggerIntf); trigger != nil {
+				triggers = append(triggers, trigger)
+			}
+		}
+	}
+
+	checkedPipelines := map[string]bool{}
+	for _, trigger := range triggers {
+		triggerName := core.StringNilMapper(trigger.Name)
+		listenerName := core.StringNilMapper(trigger.EventListener)
+		listener, ok := repository.EventListeners[listenerName]
+		if !ok {
+			report.add(Issue{Kind: IssueKindUnknownListenerConst, Trigger: triggerName,
+				Message: fmt.Sprintf("event listener %q does not exist", listenerName)})
+			continue
+		}
+		use(listener.Document)
+
+		properties := map[string]bool{}
+		for name := range pipelineProperties {
+			properties[name] = true
+		}
+		for _, property := range trigger.Properties {
+			properties[core.StringNilMapper(property.Name)] = true
+		}
+
+		for _, listenerTrigger := range listener.Triggers {
+			bound := map[string]bool{}
+			for _, binding := range listenerTrigger.Bindings {
+				if binding.Ref == "" {
+					bound[binding.Name] = true
+					continue
+				}
+				triggerBinding, ok := repository.TriggerBindings[binding.Ref]
+				if !ok {
+					report.add(Issue{Kind: IssueKindUnknownBindingConst, Trigger: triggerName, DefinitionID: listener.DefinitionID, File: listener.File,
+						Message: fmt.Sprintf("event listener %s references trigger binding %q, which does not exist", listener.Name, binding.Ref)})
+					continue
+				}
+				use(triggerBinding.Document)
+				for _, param := range triggerBinding.Params {
+					bound[param.Name] = true
+				}
+			}
+
+			templateName := listenerTrigger.Template.Ref
+			if templateName == "" {
+				templateName = listenerTrigger.Template.Name
+			}
+			template, ok := repository.TriggerTemplates[templateName]
+			if !ok {
+				report.add(Issue{Kind: IssueKindUnknownTemplateConst, Trigger: triggerName, DefinitionID: listener.DefinitionID, File: listener.File,
+					Message: fmt.Sprintf("event listener %s references trigger template %q, which does not exist", listener.Name, templateName)})
+				continue
+			}
+			use(template.Document)
+			for _, param := range template.Params {
+				if param.Default == nil && !bound[param.Name] && !properties[param.Name] {
+					report.add(Issue{Kind: IssueKindUnmatchedParamConst, Trigger: triggerName, DefinitionID: template.DefinitionID, File: template.File,
+						Message: fmt.Sprintf("parameter %s of trigger template %s has no default, binding or property", param.Name, template.Name)})
+				}
+			}
+			for _, pipelineName := range template.PipelineRefs {
+				repository.checkPipeline(report, template, pipelineName, checkedPipelines, use)
+			}
+		}
+	}
+
+	for _, definitionID := range repository.DefinitionIDs {
+		if !used[definitionID] {
+			report.add(Issue{Kind: IssueKindUnusedDefinitionConst, DefinitionID: definitionID,
+				Message: fmt.Sprintf("no trigger uses any document of definition %s", definitionID)})
+		}
+	}
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		a, b := report.Issues[i], report.Issues[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Trigger != b.Trigger {
+			return a.Trigger < b.Trigger
+		}
+		return a.Message < b.Message
+	})
+	return report
+}
+
+// checkPipeline marks a pipeline referenced by a trigger template and its tasks as used, reporting the references
+// that do not exist. Each pipeline is checked once.
+func (repository *Repository) checkPipeline(report *CheckReport, template *TriggerTemplate, pipelineName string, checked map[string]bool, use func(Document)) {
+	pipeline, ok := repository.Pipelines[pipelineName]
+	if !ok {
+		report.add(Issue{Kind: IssueKindUnknownPipelineConst, DefinitionID: template.DefinitionID, File: template.File,
+			Message: fmt.Sprintf("trigger template %s references pipeline %q, which does not exist", template.Name, pipelineName)})
+		return
+	}
+	use(pipeline.Document)
+	if checked[pipelineName] {
+		return
+	}
+	checked[pipelineName] = true
+	for _, taskName := range pipeline.TaskRefs {
+		task, ok := repository.Tasks[taskName]
+		if !ok {
+			report.add(Issue{Kind: IssueKindUnknownTaskConst, DefinitionID: pipeline.DefinitionID, File: pipeline.File,
+				Message: fmt.Sprintf("pipeline %s references task %q, which does not exist", pipeline.Name, taskName)})
+			continue
+		}
+		use(task.Document)
+	}
+}
+
+// add adds an issue unless the same issue was already reported.
+func (report *CheckReport) add(issue Issue) {
+	for _, existing := range report.Issues {
+		if existing == issue {
+			return
+		}
+	}
+	report.Issues = append(report.Issues, issue)
+}
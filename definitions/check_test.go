@@ -0,0 +1,66 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package definitions_test
+
+import (
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/definitions"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Repository.Check(pipeline)`, func() {
+	var repository *definitions.Repository
+	BeforeEach(func() {
+		repository = definitions.NewRepository()
+		Expect(repository.Load("def-listener", "listener.yaml", []byte(listenerYAML))).To(Succeed())
+		Expect(repository.Load("def-pipeline", "pipeline.yaml", []byte(pipelineYAML))).To(Succeed())
+		Expect(repository.Load("def-tasks", "tasks.yaml", []byte(tasksYAML))).To(Succeed())
+		repository.DefinitionIDs = append(repository.DefinitionIDs, "def-listener", "def-pipeline", "def-tasks", "def-unused")
+	})
+
+	It(`Reports unknown listeners, references, unmatched params and unused definitions`, func() {
+		pipeline := &cdtektonpipelinev2.TektonPipeline{
+			Properties: []cdtektonpipelinev2.Property{{Name: core.StringPtr("repository")}},
+			Triggers: []cdtektonpipelinev2.TriggerIntf{
+				&cdtektonpipelinev2.Trigger{Name: core.StringPtr("ci"), EventListener: core.StringPtr("ci-listener")},
+				&cdtektonpipelinev2.TriggerManualTrigger{Name: core.StringPtr("deploy"), EventListener: core.StringPtr("cd-listner")},
+			},
+		}
+		report := repository.Check(pipeline)
+		Expect(report.OK()).To(BeFalse())
+		Expect(report.Issues).To(Equal([]definitions.Issue{
+			{Kind: "unknown-listener", Trigger: "deploy", Message: `event listener "cd-listner" does not exist`},
+			{Kind: "unknown-task", DefinitionID: "def-pipeline", File: "pipeline.yaml", Message: `pipeline ci-pipeline references task "scan-task", which does not exist`},
+			{Kind: "unmatched-param", Trigger: "ci", DefinitionID: "def-listener", File: "listener.yaml", Message: "parameter region of trigger template ci-template has no default, binding or property"},
+			{Kind: "unused-definition", DefinitionID: "def-unused", Message: "no trigger uses any document of definition def-unused"},
+		}))
+	})
+	It(`Accepts trigger properties for template params`, func() {
+		repository.DefinitionIDs = repository.DefinitionIDs[:3]
+		repository.Tasks["scan-task"] = &definitions.Task{Document: definitions.Document{Kind: "Task", Name: "scan-task", DefinitionID: "def-tasks"}}
+		pipeline := &cdtektonpipelinev2.TektonPipeline{
+			Properties: []cdtektonpipelinev2.Property{{Name: core.StringPtr("repository")}},
+			Triggers: []cdtektonpipelinev2.TriggerIntf{
+				&cdtektonpipelinev2.Trigger{Name: core.StringPtr("ci"), EventListener: core.StringPtr("ci-listener"),
+					Properties: []cdtektonpipelinev2.TriggerProperty{{Name: core.StringPtr("region")}}},
+			},
+		}
+		Expect(repository.Check(pipeline).OK()).To(BeTrue())
+	})
+})
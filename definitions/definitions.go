@@ -0,0 +1,379 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package definitions : Loads Tekton definition repositories and checks pipelines against them
+package definitions
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Constants for the Tekton document kinds read by the loader.
+const (
+	KindEventListenerConst   = "EventListener"
+	KindTriggerTemplateConst = "TriggerTemplate"
+	KindTriggerBindingConst  = "TriggerBinding"
+	KindPipelineConst        = "Pipeline"
+	KindTaskConst            = "Task"
+)
+
+// Param : A parameter declared by a TriggerTemplate, Pipeline or Task.
+type Param struct {
+	// The name of the parameter.
+	Name string `yaml:"name"`
+
+	// The description of the parameter.
+	Description string `yaml:"description"`
+
+	// The default value of the parameter, nil if it has none.
+	Default interface{} `yaml:"default"`
+}
+
+// ParamValue : A parameter value set by a TriggerBinding, EventListener trigger or resource template.
+type ParamValue struct {
+	// The name of the parameter.
+	Name string `yaml:"name"`
+
+	// The value of the parameter.
+	Value string `yaml:"value"`
+}
+
+// Document : Common fields of the loaded documents.
+type Document struct {
+	// The kind of the document.
+	Kind string
+
+	// The name of the document, from its metadata.
+	Name string
+
+	// The file the document was read from.
+	File string
+
+	// The ID of the definition the document belongs to.
+	DefinitionID string
+}
+
+// EventListener : A Tekton EventListener.
+type EventListener struct {
+	Document
+
+	// The triggers of the listener.
+	Triggers []EventListenerTrigger
+}
+
+// EventListenerTrigger : A trigger of an EventListener.
+type EventListenerTrigger struct {
+	// The name of the trigger.
+	Name string `yaml:"name"`
+
+	// The bindings of the trigger.
+	Bindings []EventListenerBinding `yaml:"bindings"`
+
+	// The template of the trigger.
+	Template struct {
+		// The name of the TriggerTemplate.
+		Ref string `yaml:"ref"`
+
+		// The name of the TriggerTemplate, in older versions of Tekton Triggers.
+		Name string `yaml:"name"`
+	} `yaml:"template"`
+}
+
+// EventListenerBinding : A binding of an EventListener trigger, either a reference to a TriggerBinding or an inline
+// parameter value.
+type EventListenerBinding struct {
+	// The name of the referenced TriggerBinding.
+	Ref string `yaml:"ref"`
+
+	// The name of an inline parameter.
+	Name string `yaml:"name"`
+
+	// The value of an inline parameter.
+	Value string `yaml:"value"`
+}
+
+// TriggerTemplate : A Tekton TriggerTemplate.
+type TriggerTemplate struct {
+	Document
+
+	// The parameters of the template.
+	Params []Param
+
+	// The names of the pipelines referenced by the resource templates.
+	PipelineRefs []string
+}
+
+// TriggerBinding : A Tekton TriggerBinding.
+type TriggerBinding struct {
+	Document
+
+	// The parameters set by the binding.
+	Params []ParamValue
+}
+
+// Pipeline : A Tekton Pipeline.
+type Pipeline struct {
+	Document
+
+	// The parameters of the pipeline.
+	Params []Param
+
+	// The names of the tasks referenced by the pipeline tasks and finally tasks.
+	TaskRefs []string
+}
+
+// Task : A Tekton Task.
+type Task struct {
+	Document
+
+	// The parameters of the task.
+	Params []Param
+}
+
+// Repository : The Tekton documents of the definitions of a pipeline, by name.
+type Repository struct {
+	EventListeners   map[string]*EventListener
+	TriggerTemplates map[string]*TriggerTemplate
+	TriggerBindings  map[string]*TriggerBinding
+	Pipelines        map[string]*Pipeline
+	Tasks            map[string]*Task
+
+	// The IDs of the loaded definitions.
+	DefinitionIDs []string
+}
+
+// NewRepository returns an empty repository.
+func NewRepository() *Repository {
+	return &Repository{
+		EventListeners:   map[string]*EventListener{},
+		TriggerTemplates: map[string]*TriggerTemplate{},
+		TriggerBindings:  map[string]*TriggerBinding{},
+		Pipelines:        map[string]*Pipeline{},
+		Tasks:            map[string]*Task{},
+	}
+}
+
+// LoadPipelineDefinitions loads the definitions of a pipeline from local checkouts of their repositories. checkouts
+// maps each definition repository URL to the directory of its checkout; the files of a definition are read from the
+// definition's path within it.
+func LoadPipelineDefinitions(pipeline *cdtektonpipelinev2.TektonPipeline, checkouts map[string]string) (repository *Repository, err error) {
+	err = core.ValidateNotNil(pipeline, "pipeline cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	repository = NewRepository()
+	for _, definition := range pipeline.Definitions {
+		if definition.Source == nil || definition.Source.Properties == nil {
+			continue
+		}
+		properties := definition.Source.Properties
+		url := core.StringNilMapper(properties.URL)
+		checkout, ok := checkouts[url]
+		if !ok {
+			err = core.SDKErrorf(nil, fmt.Sprintf("no checkout of %s for definition %s", url, core.StringNilMapper(definition.ID)), "missing-checkout", common.GetComponentInfo())
+			return
+		}
+		err = repository.LoadDirectory(core.StringNilMapper(definition.ID), filepath.Join(checkout, core.StringNilMapper(properties.Path)))
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// LoadDirectory loads every `.yaml` and `.yml` file in a directory and its subdirectories as the documents of the
+// specified definition. Documents of other kinds are ignored. A document whose name is already loaded is an error.
+func (repository *Repository) LoadDirectory(definitionID string, dir string) error {
+	repository.DefinitionIDs = append(repository.DefinitionIDs, definitionID)
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if extension := strings.ToLower(filepath.Ext(path)); !entry.IsDir() && (extension == ".yaml" || extension == ".yml") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return core.SDKErrorf(err, "", "read-definitions-error", common.GetComponentInfo())
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return core.SDKErrorf(err, "", "read-definitions-error", common.GetComponentInfo())
+		}
+		if err = repository.Load(definitionID, file, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rawDocument : The fields shared by all Kubernetes documents.
+type rawDocument struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec yaml.Node `yaml:"spec"`
+}
+
+// Load parses the documents of a single YAML file. Documents that are not mappings with a `kind`, such as lists,
+// scalars, empty documents or values files, are skipped.
+func (repository *Repository) Load(definitionID string, file string, data []byte) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("%s: %s", file, err.Error()), "parse-definitions-error", common.GetComponentInfo())
+		}
+		if !hasKind(&node) {
+			continue
+		}
+		var raw rawDocument
+		if err = node.Decode(&raw); err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("%s: %s", file, err.Error()), "parse-definitions-error", common.GetComponentInfo())
+		}
+		document := Document{Kind: raw.Kind, Name: raw.Metadata.Name, File: file, DefinitionID: definitionID}
+		if err = repository.add(document, &raw.Spec); err != nil {
+			return err
+		}
+	}
+}
+
+// hasKind returns true if a YAML document is a mapping with a `kind` key.
+func hasKind(node *yaml.Node) bool {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == "kind" {
+			return true
+		}
+	}
+	return false
+}
+
+// add decodes the spec of a document and adds it to the repository.
+func (repository *Repository) add(document Document, spec *yaml.Node) (err error) {
+	var exists bool
+	switch document.Kind {
+	case KindEventListenerConst:
+		var decoded struct {
+			Triggers []EventListenerTrigger `yaml:"triggers"`
+		}
+		err = decodeSpec(spec, &decoded)
+		_, exists = repository.EventListeners[document.Name]
+		repository.EventListeners[document.Name] = &EventListener{Document: document, Triggers: decoded.Triggers}
+	case KindTriggerTemplateConst:
+		var decoded struct {
+			Params            []Param `yaml:"params"`
+			ResourceTemplates []struct {
+				Kind string `yaml:"kind"`
+				Spec struct {
+					PipelineRef struct {
+						Name string `yaml:"name"`
+					} `yaml:"pipelineRef"`
+				} `yaml:"spec"`
+			} `yaml:"resourcetemplates"`
+		}
+		err = decodeSpec(spec, &decoded)
+		template := &TriggerTemplate{Document: document, Params: decoded.Params}
+		for _, resource := range decoded.ResourceTemplates {
+			if resource.Spec.PipelineRef.Name != "" {
+				template.PipelineRefs = append(template.PipelineRefs, resource.Spec.PipelineRef.Name)
+			}
+		}
+		_, exists = repository.TriggerTemplates[document.Name]
+		repository.TriggerTemplates[document.Name] = template
+	case KindTriggerBindingConst:
+		var decoded struct {
+			Params []ParamValue `yaml:"params"`
+		}
+		err = decodeSpec(spec, &decoded)
+		_, exists = repository.TriggerBindings[document.Name]
+		repository.TriggerBindings[document.Name] = &TriggerBinding{Document: document, Params: decoded.Params}
+	case KindPipelineConst:
+		type pipelineTask struct {
+			TaskRef struct {
+				Name string `yaml:"name"`
+			} `yaml:"taskRef"`
+		}
+		var decoded struct {
+			Params  []Param        `yaml:"params"`
+			Tasks   []pipelineTask `yaml:"tasks"`
+			Finally []pipelineTask `yaml:"finally"`
+		}
+		err = decodeSpec(spec, &decoded)
+		pipeline := &Pipeline{Document: document, Params: decoded.Params}
+		for _, task := range append(decoded.Tasks, decoded.Finally...) {
+			if task.TaskRef.Name != "" {
+				pipeline.TaskRefs = append(pipeline.TaskRefs, task.TaskRef.Name)
+			}
+		}
+		_, exists = repository.Pipelines[document.Name]
+		repository.Pipelines[document.Name] = pipeline
+	case KindTaskConst:
+		var decoded struct {
+			Params []Param `yaml:"params"`
+		}
+		err = decodeSpec(spec, &decoded)
+		_, exists = repository.Tasks[document.Name]
+		repository.Tasks[document.Name] = &Task{Document: document, Params: decoded.Params}
+	default:
+		return nil
+	}
+	if err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("%s: %s %s: %s", document.File, document.Kind, document.Name, err.Error()), "parse-definitions-error", common.GetComponentInfo())
+	}
+	if document.Name == "" {
+		return core.SDKErrorf(nil, fmt.Sprintf("%s: %s without a name", document.File, document.Kind), "parse-definitions-error", common.GetComponentInfo())
+	}
+	if exists {
+		return core.SDKErrorf(nil, fmt.Sprintf("%s: duplicate %s %s", document.File, document.Kind, document.Name), "duplicate-definition", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func decodeSpec(spec *yaml.Node, result interface{}) error {
+	if spec.Kind == 0 {
+		return nil
+	}
+	return spec.Decode(result)
+}
@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package definitions_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDefinitions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Definitions Suite")
+}
+
+// writeFiles writes the specified files, by path relative to dir.
+func writeFiles(dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+	}
+}
+
+const listenerYAML = `apiVersion: triggers.tekton.dev/v1beta1
+kind: EventListener
+metadata:
+  name: ci-listener
+spec:
+  triggers:
+    - name: ci
+      bindings:
+        - ref: git-binding
+        - name: source
+          value: ci
+      template:
+        ref: ci-template
+---
+apiVersion: triggers.tekton.dev/v1beta1
+kind: TriggerBinding
+metadata:
+  name: git-binding
+spec:
+  params:
+    - name: revision
+      value: $(body.head_commit.id)
+---
+apiVersion: triggers.tekton.dev/v1beta1
+kind: TriggerTemplate
+metadata:
+  name: ci-template
+spec:
+  params:
+    - name: revision
+    - name: source
+    - name: repository
+    - name: region
+    - name: verbose
+      default: "false"
+  resourcetemplates:
+    - apiVersion: tekton.dev/v1beta1
+      kind: PipelineRun
+      metadata:
+        generateName: ci-
+      spec:
+        pipelineRef:
+          name: ci-pipeline
+`
+
+const pipelineYAML = `apiVersion: tekton.dev/v1beta1
+kind: Pipeline
+metadata:
+  name: ci-pipeline
+spec:
+  params:
+    - name: revision
+  tasks:
+    - name: build
+      taskRef:
+        name: build-task
+    - name: scan
+      taskRef:
+        name: scan-task
+  finally:
+    - name: notify
+      taskRef:
+        name: notify-task
+`
+
+const tasksYAML = `apiVersion: tekton.dev/v1beta1
+kind: Task
+metadata:
+  name: build-task
+spec:
+  params:
+    - name: revision
+      description: The commit to build
+  steps:
+    - name: build
+      image: golang
+---
+apiVersion: tekton.dev/v1beta1
+kind: Task
+metadata:
+  name: notify-task
+spec:
+  steps:
+    - name: notify
+      image: alpine
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+data:
+  key: value
+`
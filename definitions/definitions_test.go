@@ -0,0 +1,102 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package definitions_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/definitions"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Loading definitions`, func() {
+	var dir string
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "definitions")
+		Expect(err).To(BeNil())
+		writeFiles(dir, map[string]string{
+			".tekton/listener.yaml":  listenerYAML,
+			".tekton/pipeline.yml":   pipelineYAML,
+			".tekton/tasks/all.yaml": tasksYAML,
+			".tekton/README.md":      "# not YAML",
+		})
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Loads every Tekton document of a directory`, func() {
+		repository := definitions.NewRepository()
+		Expect(repository.LoadDirectory("def-1", filepath.Join(dir, ".tekton"))).To(Succeed())
+		Expect(repository.DefinitionIDs).To(Equal([]string{"def-1"}))
+
+		listener := repository.EventListeners["ci-listener"]
+		Expect(listener).ToNot(BeNil())
+		Expect(listener.DefinitionID).To(Equal("def-1"))
+		Expect(listener.File).To(Equal(filepath.Join(dir, ".tekton/listener.yaml")))
+		Expect(listener.Triggers).To(HaveLen(1))
+		Expect(listener.Triggers[0].Template.Ref).To(Equal("ci-template"))
+		Expect(listener.Triggers[0].Bindings[1]).To(Equal(definitions.EventListenerBinding{Name: "source", Value: "ci"}))
+
+		Expect(repository.TriggerBindings["git-binding"].Params).To(Equal([]definitions.ParamValue{{Name: "revision", Value: "$(body.head_commit.id)"}}))
+		template := repository.TriggerTemplates["ci-template"]
+		Expect(template.Params).To(HaveLen(5))
+		Expect(template.Params[4].Default).ToNot(BeNil())
+		Expect(template.PipelineRefs).To(Equal([]string{"ci-pipeline"}))
+		Expect(repository.Pipelines["ci-pipeline"].TaskRefs).To(Equal([]string{"build-task", "scan-task", "notify-task"}))
+		Expect(repository.Tasks).To(HaveLen(2))
+		Expect(repository.Tasks["build-task"].Params[0].Description).To(Equal("The commit to build"))
+	})
+	It(`Loads the definitions of a pipeline from checkouts`, func() {
+		pipeline := &cdtektonpipelinev2.TektonPipeline{Definitions: []cdtektonpipelinev2.Definition{{
+			ID: core.StringPtr("def-1"),
+			Source: &cdtektonpipelinev2.DefinitionSource{Properties: &cdtektonpipelinev2.DefinitionSourceProperties{
+				URL:  core.StringPtr("https://github.com/org/repo"),
+				Path: core.StringPtr(".tekton/tasks"),
+			}},
+		}}}
+		repository, err := definitions.LoadPipelineDefinitions(pipeline, map[string]string{"https://github.com/org/repo": dir})
+		Expect(err).To(BeNil())
+		Expect(repository.Tasks).To(HaveLen(2))
+		Expect(repository.Pipelines).To(BeEmpty())
+
+		_, err = definitions.LoadPipelineDefinitions(pipeline, map[string]string{})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("no checkout of https://github.com/org/repo"))
+	})
+	It(`Skips documents that are not Kubernetes resources`, func() {
+		repository := definitions.NewRepository()
+		mixed := "---\n# empty\n---\n- a\n- b\n---\njust a scalar\n---\nreplicas: 3\nimage: app\n---\n" + tasksYAML
+		Expect(repository.Load("def-1", "mixed.yaml", []byte(mixed))).To(Succeed())
+		Expect(repository.Tasks).To(HaveLen(2))
+	})
+	It(`Rejects duplicate and invalid documents`, func() {
+		repository := definitions.NewRepository()
+		Expect(repository.Load("def-1", "a.yaml", []byte(tasksYAML))).To(Succeed())
+		err := repository.Load("def-2", "b.yaml", []byte(tasksYAML))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("duplicate Task build-task"))
+		err = repository.Load("def-1", "c.yaml", []byte("kind: Task\nspec: [\n"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("c.yaml"))
+	})
+})
@@ -0,0 +1,117 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package definitions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Validator : Validates definition sources against the repository tools of their pipeline's toolchain.
+type Validator struct {
+	// The client used to read pipelines.
+	PipelineClient *cdtektonpipelinev2.CdTektonPipelineV2
+
+	// The client used to list the tools of toolchains.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+}
+
+// ValidateDefinition checks a definition source before it is passed to CreateTektonPipelineDefinition or
+// ReplaceTektonPipelineDefinition. Exactly one of Branch and Tag must be set, and a repository tool with the source
+// URL must exist in the toolchain of the pipeline. Properties.Tool is set to the ID of that tool. Both clients of the
+// validator must be set.
+func (validator *Validator) ValidateDefinition(ctx context.Context, pipelineID string, source *cdtektonpipelinev2.DefinitionSource) error {
+	if err := core.ValidateNotNil(source, "source cannot be nil"); err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	if err := core.ValidateNotNil(validator.PipelineClient, "PipelineClient cannot be nil"); err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	if err := core.ValidateNotNil(validator.ToolchainClient, "ToolchainClient cannot be nil"); err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	properties := source.Properties
+	if properties == nil || core.StringNilMapper(properties.URL) == "" {
+		return core.SDKErrorf(nil, "the definition source must specify a repository URL", "missing-url", common.GetComponentInfo())
+	}
+	hasBranch := core.StringNilMapper(properties.Branch) != ""
+	hasTag := core.StringNilMapper(properties.Tag) != ""
+	if hasBranch == hasTag {
+		return core.SDKErrorf(nil, "the definition source must specify exactly one of branch and tag", "invalid-ref", common.GetComponentInfo())
+	}
+	if core.StringNilMapper(properties.Path) == "" {
+		return core.SDKErrorf(nil, "the definition source must specify a path", "missing-path", common.GetComponentInfo())
+	}
+
+	pipeline, _, err := validator.PipelineClient.GetTektonPipelineWithContext(ctx, validator.PipelineClient.NewGetTektonPipelineOptions(pipelineID))
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "get-pipeline-error")
+	}
+	if pipeline.Toolchain == nil || core.StringNilMapper(pipeline.Toolchain.ID) == "" {
+		return core.SDKErrorf(nil, fmt.Sprintf("pipeline %s has no toolchain", pipelineID), "missing-toolchain", common.GetComponentInfo())
+	}
+	toolchainID := *pipeline.Toolchain.ID
+	pager, err := validator.ToolchainClient.NewToolsPager(validator.ToolchainClient.NewListToolsOptions(toolchainID))
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "tools-pager-error")
+	}
+	tools, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "list-tools-error")
+	}
+
+	repoURL := normalizeRepoURL(*properties.URL)
+	var matches []string
+	for _, tool := range tools {
+		if toolURL, ok := tool.Parameters["repo_url"].(string); ok && normalizeRepoURL(toolURL) == repoURL && tool.ID != nil {
+			matches = append(matches, *tool.ID)
+		}
+	}
+	if len(matches) == 0 {
+		return core.SDKErrorf(nil, fmt.Sprintf("toolchain %s has no repository tool for %s", toolchainID, *properties.URL), "repository-tool-not-found", common.GetComponentInfo())
+	}
+	if properties.Tool != nil && properties.Tool.ID != nil {
+		for _, id := range matches {
+			if id == *properties.Tool.ID {
+				return nil
+			}
+		}
+		return core.SDKErrorf(nil, fmt.Sprintf("tool %s is not the repository tool for %s", *properties.Tool.ID, *properties.URL), "repository-tool-mismatch", common.GetComponentInfo())
+	}
+	properties.Tool = &cdtektonpipelinev2.Tool{ID: core.StringPtr(matches[0])}
+	return nil
+}
+
+// normalizeRepoURL returns a repository URL in a form that can be compared: without a trailing slash or `.git`
+// suffix, and with a lower-case scheme and host.
+func normalizeRepoURL(repoURL string) string {
+	repoURL = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(repoURL), "/"), ".git")
+	parsed, err := url.Parse(repoURL)
+	if err != nil || parsed.Host == "" {
+		return repoURL
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	return parsed.String()
+}
@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package definitions_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/definitions"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Validator.ValidateDefinition(ctx, pipelineID, source)`, func() {
+	var testServer *httptest.Server
+	var validator *definitions.Validator
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /tekton_pipelines/pipeline-1":
+				fmt.Fprintf(res, "%s", `{"id": "pipeline-1", "toolchain": {"id": "toolchain-1"}}`)
+			case "GET /toolchains/toolchain-1/tools":
+				fmt.Fprintf(res, "%s", `{"limit": 20, "total_count": 3, "first": {"href": "https://cloud.ibm.com"}, "tools": [
+					{"id": "tool-pipeline", "tool_type_id": "pipeline", "parameters": {}},
+					{"id": "tool-app", "tool_type_id": "githubconsolidated", "parameters": {"repo_url": "https://github.com/org/app"}},
+					{"id": "tool-defs", "tool_type_id": "hostedgit", "parameters": {"repo_url": "https://us-south.git.cloud.ibm.com/org/definitions.git"}}
+				]}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+		pipelineClient, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		toolchainClient, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		validator = &definitions.Validator{PipelineClient: pipelineClient, ToolchainClient: toolchainClient}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	source := func(url string, branch string, tag string) *cdtektonpipelinev2.DefinitionSource {
+		properties := &cdtektonpipelinev2.DefinitionSourceProperties{URL: core.StringPtr(url), Path: core.StringPtr(".tekton")}
+		if branch != "" {
+			properties.Branch = core.StringPtr(branch)
+		}
+		if tag != "" {
+			properties.Tag = core.StringPtr(tag)
+		}
+		return &cdtektonpipelinev2.DefinitionSource{Type: core.StringPtr("git"), Properties: properties}
+	}
+
+	It(`Fills in the repository tool`, func() {
+		definitionSource := source("https://US-South.git.cloud.ibm.com/org/definitions/", "main", "")
+		Expect(validator.ValidateDefinition(context.Background(), "pipeline-1", definitionSource)).To(Succeed())
+		Expect(*definitionSource.Properties.Tool.ID).To(Equal("tool-defs"))
+	})
+	It(`Enforces exactly one of branch and tag`, func() {
+		err := validator.ValidateDefinition(context.Background(), "pipeline-1", source("https://github.com/org/app", "main", "v1.0.0"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("exactly one of branch and tag"))
+		err = validator.ValidateDefinition(context.Background(), "pipeline-1", source("https://github.com/org/app", "", ""))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Rejects URLs without a repository tool`, func() {
+		err := validator.ValidateDefinition(context.Background(), "pipeline-1", source("https://github.com/org/other", "", "v1.0.0"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("toolchain toolchain-1 has no repository tool for https://github.com/org/other"))
+	})
+	It(`Rejects a tool that does not match the URL`, func() {
+		definitionSource := source("https://github.com/org/app", "main", "")
+		definitionSource.Properties.Tool = &cdtektonpipelinev2.Tool{ID: core.StringPtr("tool-defs")}
+		err := validator.ValidateDefinition(context.Background(), "pipeline-1", definitionSource)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("tool tool-defs is not the repository tool"))
+	})
+	It(`Rejects a validator without clients`, func() {
+		err := (&definitions.Validator{ToolchainClient: validator.ToolchainClient}).ValidateDefinition(context.Background(), "pipeline-1", source("https://github.com/org/app", "main", ""))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("PipelineClient cannot be nil"))
+		err = (&definitions.Validator{PipelineClient: validator.PipelineClient}).ValidateDefinition(context.Background(), "pipeline-1", source("https://github.com/org/app", "main", ""))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("ToolchainClient cannot be nil"))
+	})
+})
@@ -0,0 +1,361 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DORAOptions : Options for computing DORA metrics.
+type DORAOptions struct {
+	// The width of the buckets that results are grouped by. The default is PeriodDayConst.
+	Period Period
+
+	// Only runs created at or after this time are considered.
+	Since *time.Time
+
+	// Only runs created before this time are considered.
+	Until *time.Time
+}
+
+// DORAReport : The four DORA metrics for the deployments of a single pipeline trigger.
+type DORAReport struct {
+	// The ID of the pipeline.
+	PipelineID string `json:"pipeline_id,omitempty"`
+
+	// The name of the trigger whose runs are deployments.
+	TriggerName string `json:"trigger_name,omitempty"`
+
+	// The width of each bucket.
+	Period Period `json:"period"`
+
+	// Metrics for each bucket that has at least one deployment or restore, oldest first.
+	Buckets []DORABucket `json:"buckets"`
+
+	// Metrics across all buckets.
+	Total DORABucket `json:"total"`
+}
+
+// DORABucket : DORA metrics for a single time bucket.
+type DORABucket struct {
+	// Start of the bucket, in UTC.
+	Start time.Time `json:"start"`
+
+	// Number of finished deployments, successful or not. Cancelled runs are not deployments.
+	Deployments int `json:"deployments"`
+
+	// Number of successful deployments.
+	SuccessfulDeployments int `json:"successful_deployments"`
+
+	// Number of deployments that ended with status `failed` or `error`.
+	FailedDeployments int `json:"failed_deployments"`
+
+	// Successful deployments per day.
+	DeploymentFrequency float64 `json:"deployment_frequency"`
+
+	// Median time in seconds from commit to successful deployment, for commits first deployed in this bucket.
+	LeadTimeForChangesSeconds float64 `json:"lead_time_for_changes_seconds"`
+
+	// Failed deployments divided by deployments.
+	ChangeFailureRate float64 `json:"change_failure_rate"`
+
+	// Mean time in seconds from the first failed deployment to the next successful one, for failures in this bucket.
+	MeanTimeToRestoreSeconds float64 `json:"mean_time_to_restore_seconds"`
+
+	leadTimes    []float64
+	restoreTimes []float64
+}
+
+// ComputeDORA lists the runs of the specified deploy trigger with the runs pager and calculates the DORA metrics
+// from them.
+func ComputeDORA(ctx context.Context, client *cdtektonpipelinev2.CdTektonPipelineV2, pipelineID string, deployTrigger string, options *DORAOptions) (report *DORAReport, err error) {
+	if pipelineID == "" || deployTrigger == "" {
+		err = core.SDKErrorf(nil, "pipelineID and deployTrigger must be specified", "missing-required", common.GetComponentInfo())
+		return
+	}
+	runs, err := listPipelineRuns(ctx, client, pipelineID, deployTrigger)
+	if err != nil {
+		return
+	}
+	report = CalculateDORA(runs, deployTrigger, options)
+	report.PipelineID = pipelineID
+	return
+}
+
+// CalculateDORA calculates the DORA metrics from the specified runs. Only terminal runs of deployTrigger are
+// considered; when deployTrigger is "" every run is treated as a deployment. Lead times are measured from the commit
+// timestamps found in the Git payload of each run's EventParamsBlob.
+func CalculateDORA(runs []cdtektonpipelinev2.PipelineRun, deployTrigger string, options *DORAOptions) *DORAReport {
+	if options == nil {
+		options = &DORAOptions{}
+	}
+	period := options.Period
+	if period == "" {
+		period = PeriodDayConst
+	}
+	report := &DORAReport{
+		TriggerName: deployTrigger,
+		Period:      period,
+		Buckets:     []DORABucket{},
+	}
+
+	type deployment struct {
+		run      *cdtektonpipelinev2.PipelineRun
+		finished time.Time
+		failed   bool
+	}
+	var deployments []deployment
+	for i := range runs {
+		run := &runs[i]
+		status := runStatus(run)
+		if !cdtektonpipelinev2.IsTerminalPipelineRunStatus(status) || status == cdtektonpipelinev2.PipelineRunStatusCancelledConst {
+			continue
+		}
+		if deployTrigger != "" && triggerName(run) != deployTrigger {
+			continue
+		}
+		created, finished, ok := runTimes(run)
+		if !ok {
+			continue
+		}
+		if options.Since != nil && created.Before(*options.Since) {
+			continue
+		}
+		if options.Until != nil && !created.Before(*options.Until) {
+			continue
+		}
+		deployments = append(deployments, deployment{
+			run:      run,
+			finished: finished,
+			failed:   status != cdtektonpipelinev2.PipelineRunStatusSucceededConst,
+		})
+	}
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].finished.Before(deployments[j].finished)
+	})
+
+	buckets := map[time.Time]*DORABucket{}
+	bucketFor := func(t time.Time) *DORABucket {
+		start := period.bucketStart(t)
+		bucket, ok := buckets[start]
+		if !ok {
+			bucket = &DORABucket{Start: start}
+			buckets[start] = bucket
+		}
+		return bucket
+	}
+
+	deployedCommits := map[string]bool{}
+	var failingSince *time.Time
+	for _, d := range deployments {
+		bucket := bucketFor(d.finished)
+		bucket.Deployments++
+		if d.failed {
+			bucket.FailedDeployments++
+			if failingSince == nil {
+				finished := d.finished
+				failingSince = &finished
+			}
+			continue
+		}
+		bucket.SuccessfulDeployments++
+		if failingSince != nil {
+			restored := bucketFor(*failingSince)
+			restored.restoreTimes = append(restored.restoreTimes, d.finished.Sub(*failingSince).Seconds())
+			failingSince = nil
+		}
+		for _, commit := range eventCommits(d.run.EventParamsBlob) {
+			if commit.id != "" {
+				if deployedCommits[commit.id] {
+					continue
+				}
+				deployedCommits[commit.id] = true
+			}
+			if leadTime := d.finished.Sub(commit.timestamp); leadTime >= 0 {
+				bucket.leadTimes = append(bucket.leadTimes, leadTime.Seconds())
+			}
+		}
+	}
+
+	total := &report.Total
+	for _, bucket := range buckets {
+		report.Buckets = append(report.Buckets, *bucket)
+		total.Deployments += bucket.Deployments
+		total.SuccessfulDeployments += bucket.SuccessfulDeployments
+		total.FailedDeployments += bucket.FailedDeployments
+		total.leadTimes = append(total.leadTimes, bucket.leadTimes...)
+		total.restoreTimes = append(total.restoreTimes, bucket.restoreTimes...)
+	}
+	sort.Slice(report.Buckets, func(i, j int) bool {
+		return report.Buckets[i].Start.Before(report.Buckets[j].Start)
+	})
+
+	days := 1.0
+	if period == PeriodWeekConst {
+		days = 7.0
+	}
+	for i := range report.Buckets {
+		report.Buckets[i].summarize(days)
+	}
+	if len(report.Buckets) > 0 {
+		first := report.Buckets[0].Start
+		last := report.Buckets[len(report.Buckets)-1].Start
+		total.Start = first
+		total.summarize(last.Sub(first).Hours()/24 + days)
+	}
+	return report
+}
+
+// summarize fills in the derived metrics of the bucket, which spans the specified number of days.
+func (bucket *DORABucket) summarize(days float64) {
+	if days > 0 {
+		bucket.DeploymentFrequency = float64(bucket.SuccessfulDeployments) / days
+	}
+	if bucket.Deployments > 0 {
+		bucket.ChangeFailureRate = float64(bucket.FailedDeployments) / float64(bucket.Deployments)
+	}
+	bucket.LeadTimeForChangesSeconds = median(bucket.leadTimes)
+	bucket.MeanTimeToRestoreSeconds = mean(bucket.restoreTimes)
+}
+
+// WriteJSON writes the report to w as indented JSON.
+func (report *DORAReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return core.SDKErrorf(err, "", "json-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteCSV writes one CSV row per bucket to w, preceded by a header row.
+func (report *DORAReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	records := [][]string{{
+		"period_start",
+		"deployments",
+		"successful_deployments",
+		"failed_deployments",
+		"deployment_frequency",
+		"lead_time_for_changes_seconds",
+		"change_failure_rate",
+		"mean_time_to_restore_seconds",
+	}}
+	for _, bucket := range report.Buckets {
+		records = append(records, []string{
+			bucket.Start.Format(time.RFC3339),
+			strconv.Itoa(bucket.Deployments),
+			strconv.Itoa(bucket.SuccessfulDeployments),
+			strconv.Itoa(bucket.FailedDeployments),
+			formatFloat(bucket.DeploymentFrequency),
+			formatFloat(bucket.LeadTimeForChangesSeconds),
+			formatFloat(bucket.ChangeFailureRate),
+			formatFloat(bucket.MeanTimeToRestoreSeconds),
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return core.SDKErrorf(err, "", "csv-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// gitCommit : A commit found in a Git event payload.
+type gitCommit struct {
+	id        string
+	timestamp time.Time
+}
+
+// eventCommits extracts the commits from the Git payload in an EventParamsBlob. GitHub and GitLab push payloads list
+// them in `commits`, GitHub also in `head_commit`. Payloads nested under `body` are also recognised.
+func eventCommits(eventParamsBlob *string) (commits []gitCommit) {
+	if eventParamsBlob == nil || *eventParamsBlob == "" {
+		return
+	}
+	var payload map[string]interface{}
+	if json.Unmarshal([]byte(*eventParamsBlob), &payload) != nil {
+		return
+	}
+	if body, ok := payload["body"].(map[string]interface{}); ok {
+		payload = body
+	}
+	candidates := []interface{}{}
+	if list, ok := payload["commits"].([]interface{}); ok {
+		candidates = append(candidates, list...)
+	}
+	if headCommit, ok := payload["head_commit"]; ok {
+		candidates = append(candidates, headCommit)
+	}
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		object, ok := candidate.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		timestamp, _ := object["timestamp"].(string)
+		parsed, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			continue
+		}
+		id, _ := object["id"].(string)
+		if id != "" {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+		}
+		commits = append(commits, gitCommit{id: id, timestamp: parsed})
+	}
+	return
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
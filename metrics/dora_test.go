@@ -0,0 +1,166 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/metrics"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func testRun(id string, trigger string, status string, created string, updated string, eventParamsBlob string) cdtektonpipelinev2.PipelineRun {
+	createdAt, _ := strfmt.ParseDateTime(created)
+	run := cdtektonpipelinev2.PipelineRun{
+		ID:              core.StringPtr(id),
+		Status:          core.StringPtr(status),
+		Trigger:         &cdtektonpipelinev2.Trigger{Name: core.StringPtr(trigger)},
+		CreatedAt:       &createdAt,
+		EventParamsBlob: core.StringPtr(eventParamsBlob),
+	}
+	if updated != "" {
+		updatedAt, _ := strfmt.ParseDateTime(updated)
+		run.UpdatedAt = &updatedAt
+	}
+	return run
+}
+
+var _ = Describe(`DORA metrics`, func() {
+	pushEvent := `{"head_commit": {"id": "c2", "timestamp": "2025-03-03T08:00:00Z"}, "commits": [{"id": "c1", "timestamp": "2025-03-03T06:00:00Z"}, {"id": "c2", "timestamp": "2025-03-03T08:00:00Z"}]}`
+	runs := []cdtektonpipelinev2.PipelineRun{
+		testRun("1", "deploy", "succeeded", "2025-03-03T09:00:00Z", "2025-03-03T10:00:00Z", pushEvent),
+		testRun("2", "deploy", "failed", "2025-03-04T09:00:00Z", "2025-03-04T10:00:00Z", `{}`),
+		testRun("3", "deploy", "succeeded", "2025-03-04T11:00:00Z", "2025-03-04T12:00:00Z", pushEvent),
+		testRun("4", "deploy", "running", "2025-03-04T13:00:00Z", "", `{}`),
+		testRun("5", "deploy", "cancelled", "2025-03-04T13:00:00Z", "2025-03-04T13:05:00Z", `{}`),
+		testRun("6", "build", "succeeded", "2025-03-04T09:00:00Z", "2025-03-04T09:10:00Z", pushEvent),
+	}
+
+	Describe(`CalculateDORA`, func() {
+		It(`Groups deployments by day`, func() {
+			report := metrics.CalculateDORA(runs, "deploy", nil)
+			Expect(report.Period).To(Equal(metrics.PeriodDayConst))
+			Expect(report.Buckets).To(HaveLen(2))
+
+			day1 := report.Buckets[0]
+			Expect(day1.Start).To(Equal(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)))
+			Expect(day1.Deployments).To(Equal(1))
+			Expect(day1.DeploymentFrequency).To(Equal(1.0))
+			Expect(day1.LeadTimeForChangesSeconds).To(Equal(3 * 3600.0))
+			Expect(day1.ChangeFailureRate).To(Equal(0.0))
+
+			day2 := report.Buckets[1]
+			Expect(day2.Deployments).To(Equal(2))
+			Expect(day2.FailedDeployments).To(Equal(1))
+			Expect(day2.ChangeFailureRate).To(Equal(0.5))
+			// The commits were already deployed by run 1.
+			Expect(day2.LeadTimeForChangesSeconds).To(Equal(0.0))
+			Expect(day2.MeanTimeToRestoreSeconds).To(Equal(2 * 3600.0))
+
+			Expect(report.Total.Deployments).To(Equal(3))
+			Expect(report.Total.SuccessfulDeployments).To(Equal(2))
+			Expect(report.Total.DeploymentFrequency).To(Equal(1.0))
+		})
+		It(`Groups deployments by week`, func() {
+			report := metrics.CalculateDORA(runs, "deploy", &metrics.DORAOptions{Period: metrics.PeriodWeekConst})
+			Expect(report.Buckets).To(HaveLen(1))
+			Expect(report.Buckets[0].Start).To(Equal(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)))
+			Expect(report.Buckets[0].Deployments).To(Equal(3))
+			Expect(report.Buckets[0].DeploymentFrequency).To(BeNumerically("~", 2.0/7.0))
+		})
+		It(`Honours the time window`, func() {
+			since := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+			report := metrics.CalculateDORA(runs, "deploy", &metrics.DORAOptions{Since: &since})
+			Expect(report.Buckets).To(HaveLen(1))
+			Expect(report.Buckets[0].LeadTimeForChangesSeconds).To(Equal(29 * 3600.0))
+		})
+		It(`Writes JSON and CSV`, func() {
+			report := metrics.CalculateDORA(runs, "deploy", nil)
+
+			var jsonOut bytes.Buffer
+			Expect(report.WriteJSON(&jsonOut)).To(Succeed())
+			var decoded map[string]interface{}
+			Expect(json.Unmarshal(jsonOut.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded["trigger_name"]).To(Equal("deploy"))
+			Expect(decoded["buckets"]).To(HaveLen(2))
+
+			var csvOut bytes.Buffer
+			Expect(report.WriteCSV(&csvOut)).To(Succeed())
+			lines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+			Expect(lines).To(HaveLen(3))
+			Expect(lines[0]).To(HavePrefix("period_start,deployments,"))
+			Expect(lines[2]).To(Equal("2025-03-04T00:00:00Z,2,1,1,1,0,0.5,7200"))
+		})
+	})
+
+	Describe(`ComputeDORA`, func() {
+		var testServer *httptest.Server
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/tekton_pipelines/pipeline-1/pipeline_runs"))
+				Expect(req.URL.Query()["trigger.name"]).To(Equal([]string{"deploy"}))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				if req.URL.Query().Get("start") == "" {
+					fmt.Fprintf(res, "%s", `{"pipeline_runs": [{"id": "1", "status": "succeeded", "trigger": {"name": "deploy"}, "created_at": "2025-03-03T09:00:00.000Z", "updated_at": "2025-03-03T10:00:00.000Z", "event_params_blob": "{}"}], "limit": 1, "first": {"href": "Href"}, "next": {"href": "https://myhost.com/somePath?start=1"}}`)
+				} else {
+					fmt.Fprintf(res, "%s", `{"pipeline_runs": [{"id": "2", "status": "failed", "trigger": {"name": "deploy"}, "created_at": "2025-03-03T11:00:00.000Z", "updated_at": "2025-03-03T12:00:00.000Z", "event_params_blob": "{}"}], "limit": 1, "first": {"href": "Href"}}`)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Invoke ComputeDORA successfully`, func() {
+			cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			report, err := metrics.ComputeDORA(context.Background(), cdTektonPipelineService, "pipeline-1", "deploy", nil)
+			Expect(err).To(BeNil())
+			Expect(report.PipelineID).To(Equal("pipeline-1"))
+			Expect(report.Total.Deployments).To(Equal(2))
+			Expect(report.Total.ChangeFailureRate).To(Equal(0.5))
+		})
+		It(`Invoke ComputeDORA with error: missing trigger`, func() {
+			cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			report, err := metrics.ComputeDORA(context.Background(), cdTektonPipelineService, "pipeline-1", "", nil)
+			Expect(err).ToNot(BeNil())
+			Expect(report).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,96 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics : Delivery metrics computed from Tekton pipeline run history
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Period : The width of the time buckets that results are grouped by.
+type Period string
+
+// Constants associated with the Period type.
+const (
+	PeriodDayConst  Period = "day"
+	PeriodWeekConst Period = "week"
+)
+
+// bucketStart returns the start of the bucket containing t. Weeks start on Monday, all buckets are in UTC.
+func (period Period) bucketStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if period == PeriodWeekConst {
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	}
+	return day
+}
+
+// listPipelineRuns fetches every run of a pipeline, optionally restricted to a single trigger, using the runs pager.
+func listPipelineRuns(ctx context.Context, client *cdtektonpipelinev2.CdTektonPipelineV2, pipelineID string, triggerName string) (runs []cdtektonpipelinev2.PipelineRun, err error) {
+	listOptions := client.NewListTektonPipelineRunsOptions(pipelineID)
+	if triggerName != "" {
+		listOptions.SetTriggerName(triggerName)
+	}
+	pager, err := client.NewTektonPipelineRunsPager(listOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "pager-error", common.GetComponentInfo())
+		return
+	}
+	runs, err = pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-runs-error")
+	}
+	return
+}
+
+// triggerName returns the name of the trigger that started a pipeline run, or "" when it is unknown.
+func triggerName(run *cdtektonpipelinev2.PipelineRun) string {
+	if trigger := cdtektonpipelinev2.AsTrigger(run.Trigger); trigger != nil && trigger.Name != nil {
+		return *trigger.Name
+	}
+	return ""
+}
+
+// runStatus returns the status of a run, or "" when it is not set.
+func runStatus(run *cdtektonpipelinev2.PipelineRun) string {
+	if run.Status == nil {
+		return ""
+	}
+	return *run.Status
+}
+
+// runTimes returns the creation and last update time of a run. The finish time of a terminal run is its last update
+// time, falling back to its creation time when the run was never updated.
+func runTimes(run *cdtektonpipelinev2.PipelineRun) (created time.Time, finished time.Time, ok bool) {
+	if run.CreatedAt == nil {
+		return
+	}
+	created = time.Time(*run.CreatedAt)
+	finished = created
+	if run.UpdatedAt != nil {
+		finished = time.Time(*run.UpdatedAt)
+	}
+	ok = true
+	return
+}
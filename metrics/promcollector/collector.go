@@ -0,0 +1,347 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package promcollector : A Prometheus collector for Tekton pipeline and toolchain state
+package promcollector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultInterval is the polling interval used when CollectorOptions.Interval is not set.
+const DefaultInterval = time.Minute
+
+// DefaultRunsLimit is the number of most recent runs polled per pipeline when CollectorOptions.RunsLimit is not set.
+const DefaultRunsLimit = 50
+
+// DefaultNamespace is the metric name prefix used when CollectorOptions.Namespace is not set.
+const DefaultNamespace = "cd"
+
+// CollectorOptions : Options for the Collector.
+type CollectorOptions struct {
+	// The client used to poll pipelines. Required when PipelineIDs is set.
+	PipelineClient *cdtektonpipelinev2.CdTektonPipelineV2
+
+	// The client used to poll toolchains. Required when ToolchainIDs or ResourceGroupIDs is set.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// IDs of the Tekton pipelines to poll.
+	PipelineIDs []string
+
+	// IDs of the toolchains whose tools are polled.
+	ToolchainIDs []string
+
+	// Resource groups in which every toolchain is polled, in addition to ToolchainIDs.
+	ResourceGroupIDs []string
+
+	// Time between two polls.
+	Interval time.Duration
+
+	// Number of most recent runs fetched per pipeline on each poll.
+	RunsLimit int64
+
+	// Prefix of every metric name.
+	Namespace string
+}
+
+// Collector : A prometheus.Collector that periodically polls Tekton pipelines and toolchains and exposes their state.
+// Register it with a prometheus.Registerer and call Start to begin polling.
+type Collector struct {
+	options CollectorOptions
+
+	runs            *prometheus.GaugeVec
+	buildNumber     *prometheus.GaugeVec
+	triggerEnabled  *prometheus.GaugeVec
+	toolState       *prometheus.GaugeVec
+	runDuration     *prometheus.HistogramVec
+	pollErrors      prometheus.Counter
+	lastPollSuccess prometheus.Gauge
+
+	mutex        sync.Mutex
+	observedRuns map[string]map[string]bool
+}
+
+// NewCollector returns a new Collector.
+func NewCollector(options *CollectorOptions) (collector *Collector, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if len(options.PipelineIDs) > 0 && options.PipelineClient == nil {
+		err = core.SDKErrorf(nil, "a PipelineClient is required to poll pipelines", "missing-pipeline-client", common.GetComponentInfo())
+		return
+	}
+	if (len(options.ToolchainIDs) > 0 || len(options.ResourceGroupIDs) > 0) && options.ToolchainClient == nil {
+		err = core.SDKErrorf(nil, "a ToolchainClient is required to poll toolchains", "missing-toolchain-client", common.GetComponentInfo())
+		return
+	}
+
+	collector = &Collector{
+		options:      *options,
+		observedRuns: map[string]map[string]bool{},
+	}
+	if collector.options.Interval <= 0 {
+		collector.options.Interval = DefaultInterval
+	}
+	if collector.options.RunsLimit <= 0 {
+		collector.options.RunsLimit = DefaultRunsLimit
+	}
+	if collector.options.Namespace == "" {
+		collector.options.Namespace = DefaultNamespace
+	}
+	namespace := collector.options.Namespace
+
+	collector.runs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "tekton_pipeline",
+		Name:      "runs",
+		Help:      "Number of recent pipeline runs by status.",
+	}, []string{"pipeline_id", "status"})
+	collector.buildNumber = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "tekton_pipeline",
+		Name:      "build_number",
+		Help:      "The latest pipeline run build number.",
+	}, []string{"pipeline_id"})
+	collector.triggerEnabled = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "tekton_pipeline",
+		Name:      "trigger_enabled",
+		Help:      "1 if the trigger is enabled, 0 otherwise.",
+	}, []string{"pipeline_id", "trigger"})
+	collector.toolState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "toolchain",
+		Name:      "tool_state",
+		Help:      "1 for the current configuration state of each tool.",
+	}, []string{"toolchain_id", "tool_id", "tool_type_id", "state"})
+	collector.runDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "tekton_pipeline",
+		Name:      "run_duration_seconds",
+		Help:      "Duration of finished pipeline runs, from creation to last update.",
+		Buckets:   prometheus.ExponentialBuckets(30, 2, 10),
+	}, []string{"pipeline_id", "trigger", "status"})
+	collector.pollErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "poll_errors_total",
+		Help:      "Number of failed API calls while polling.",
+	})
+	collector.lastPollSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_poll_success",
+		Help:      "1 if every API call of the last poll succeeded, 0 otherwise.",
+	})
+	return
+}
+
+// Describe implements prometheus.Collector.
+func (collector *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range collector.collectors() {
+		c.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector. It reports the state observed by the most recent poll.
+func (collector *Collector) Collect(ch chan<- prometheus.Metric) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	for _, c := range collector.collectors() {
+		c.Collect(ch)
+	}
+}
+
+func (collector *Collector) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		collector.runs,
+		collector.buildNumber,
+		collector.triggerEnabled,
+		collector.toolState,
+		collector.runDuration,
+		collector.pollErrors,
+		collector.lastPollSuccess,
+	}
+}
+
+// Start polls immediately and then every Interval until ctx is done. It returns without blocking.
+func (collector *Collector) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(collector.options.Interval)
+		defer ticker.Stop()
+		for {
+			_ = collector.Poll(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Poll fetches the current state of every configured pipeline and toolchain and updates the metrics. Failed API calls
+// are counted and the first error is returned; the metrics of the resources that could be fetched are still updated.
+func (collector *Collector) Poll(ctx context.Context) (err error) {
+	pipelines := map[string]*cdtektonpipelinev2.TektonPipeline{}
+	pipelineRuns := map[string][]cdtektonpipelinev2.PipelineRun{}
+	tools := map[string][]cdtoolchainv2.ToolModel{}
+	failures := 0
+	record := func(callErr error) bool {
+		if callErr == nil {
+			return true
+		}
+		failures++
+		if err == nil {
+			err = callErr
+		}
+		return false
+	}
+
+	for _, pipelineID := range collector.options.PipelineIDs {
+		client := collector.options.PipelineClient
+		pipeline, _, callErr := client.GetTektonPipelineWithContext(ctx, client.NewGetTektonPipelineOptions(pipelineID))
+		if record(callErr) {
+			pipelines[pipelineID] = pipeline
+		}
+		listOptions := client.NewListTektonPipelineRunsOptions(pipelineID).SetLimit(collector.options.RunsLimit)
+		runs, _, callErr := client.ListTektonPipelineRunsWithContext(ctx, listOptions)
+		if record(callErr) {
+			pipelineRuns[pipelineID] = runs.PipelineRuns
+		}
+	}
+
+	toolchainIDs := append([]string{}, collector.options.ToolchainIDs...)
+	for _, resourceGroupID := range collector.options.ResourceGroupIDs {
+		client := collector.options.ToolchainClient
+		pager, callErr := client.NewToolchainsPager(client.NewListToolchainsOptions(resourceGroupID))
+		if !record(callErr) {
+			continue
+		}
+		toolchains, callErr := pager.GetAllWithContext(ctx)
+		if !record(callErr) {
+			continue
+		}
+		for _, toolchain := range toolchains {
+			toolchainIDs = append(toolchainIDs, *toolchain.ID)
+		}
+	}
+	for _, toolchainID := range toolchainIDs {
+		if _, ok := tools[toolchainID]; ok {
+			continue
+		}
+		client := collector.options.ToolchainClient
+		pager, callErr := client.NewToolsPager(client.NewListToolsOptions(toolchainID))
+		if !record(callErr) {
+			continue
+		}
+		toolchainTools, callErr := pager.GetAllWithContext(ctx)
+		if record(callErr) {
+			tools[toolchainID] = toolchainTools
+		}
+	}
+
+	collector.update(pipelines, pipelineRuns, tools)
+	collector.pollErrors.Add(float64(failures))
+	if failures == 0 {
+		collector.lastPollSuccess.Set(1)
+	} else {
+		collector.lastPollSuccess.Set(0)
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "poll-error")
+	}
+	return
+}
+
+// update replaces the gauges with the polled state and observes the durations of newly finished runs. Resources that
+// could not be polled keep their previous values.
+func (collector *Collector) update(pipelines map[string]*cdtektonpipelinev2.TektonPipeline, pipelineRuns map[string][]cdtektonpipelinev2.PipelineRun, tools map[string][]cdtoolchainv2.ToolModel) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	for pipelineID, pipeline := range pipelines {
+		collector.buildNumber.DeletePartialMatch(prometheus.Labels{"pipeline_id": pipelineID})
+		collector.triggerEnabled.DeletePartialMatch(prometheus.Labels{"pipeline_id": pipelineID})
+		if pipeline.BuildNumber != nil {
+			collector.buildNumber.WithLabelValues(pipelineID).Set(float64(*pipeline.BuildNumber))
+		}
+		for _, triggerIntf := range pipeline.Triggers {
+			trigger := cdtektonpipelinev2.AsTrigger(triggerIntf)
+			if trigger == nil || trigger.Name == nil {
+				continue
+			}
+			enabled := 0.0
+			if trigger.Enabled != nil && *trigger.Enabled {
+				enabled = 1
+			}
+			collector.triggerEnabled.WithLabelValues(pipelineID, *trigger.Name).Set(enabled)
+		}
+	}
+
+	for pipelineID, runs := range pipelineRuns {
+		collector.runs.DeletePartialMatch(prometheus.Labels{"pipeline_id": pipelineID})
+		counts := map[string]float64{}
+		observed := map[string]bool{}
+		for i := range runs {
+			run := &runs[i]
+			if run.Status == nil {
+				continue
+			}
+			counts[*run.Status]++
+			if !cdtektonpipelinev2.IsTerminalPipelineRunStatus(*run.Status) || run.ID == nil || run.CreatedAt == nil || run.UpdatedAt == nil {
+				continue
+			}
+			observed[*run.ID] = true
+			if collector.observedRuns[pipelineID][*run.ID] {
+				continue
+			}
+			triggerName := ""
+			if trigger := cdtektonpipelinev2.AsTrigger(run.Trigger); trigger != nil && trigger.Name != nil {
+				triggerName = *trigger.Name
+			}
+			duration := time.Time(*run.UpdatedAt).Sub(time.Time(*run.CreatedAt))
+			collector.runDuration.WithLabelValues(pipelineID, triggerName, *run.Status).Observe(duration.Seconds())
+		}
+		// Runs that dropped out of the polled page never come back, so only the current page needs remembering.
+		collector.observedRuns[pipelineID] = observed
+		for status, count := range counts {
+			collector.runs.WithLabelValues(pipelineID, status).Set(count)
+		}
+	}
+
+	for toolchainID, toolchainTools := range tools {
+		collector.toolState.DeletePartialMatch(prometheus.Labels{"toolchain_id": toolchainID})
+		for _, tool := range toolchainTools {
+			if tool.ID == nil || tool.State == nil {
+				continue
+			}
+			toolTypeID := ""
+			if tool.ToolTypeID != nil {
+				toolTypeID = *tool.ToolTypeID
+			}
+			collector.toolState.WithLabelValues(toolchainID, *tool.ID, toolTypeID, *tool.State).Set(1)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package promcollector_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/metrics/promcollector"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe(`Collector`, func() {
+	var testServer *httptest.Server
+	var failRuns bool
+	BeforeEach(func() {
+		failRuns = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/tekton_pipelines/pipeline-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "pipeline-1", "build_number": 42, "triggers": [{"name": "build", "enabled": true}, {"name": "deploy", "enabled": false}]}`)
+			case "/tekton_pipelines/pipeline-1/pipeline_runs":
+				if failRuns {
+					res.WriteHeader(500)
+					fmt.Fprintf(res, "%s", `{"errors": [{"message": "boom"}]}`)
+					return
+				}
+				Expect(req.URL.Query()["limit"]).To(Equal([]string{"50"}))
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"pipeline_runs": [`+
+					`{"id": "1", "status": "succeeded", "trigger": {"name": "build"}, "created_at": "2025-03-03T09:00:00.000Z", "updated_at": "2025-03-03T09:01:00.000Z"},`+
+					`{"id": "2", "status": "failed", "trigger": {"name": "build"}, "created_at": "2025-03-03T09:00:00.000Z", "updated_at": "2025-03-03T09:05:00.000Z"},`+
+					`{"id": "3", "status": "running", "trigger": {"name": "build"}, "created_at": "2025-03-03T09:00:00.000Z"}`+
+					`], "limit": 50, "first": {"href": "Href"}}`)
+			case "/toolchains":
+				Expect(req.URL.Query()["resource_group_id"]).To(Equal([]string{"rg-1"}))
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"total_count": 1, "limit": 20, "first": {"href": "Href"}, "last": {"href": "Href"}, "toolchains": [{"id": "toolchain-1"}]}`)
+			case "/toolchains/toolchain-1/tools":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"limit": 20, "total_count": 2, "first": {"href": "Href"}, "last": {"href": "Href"}, "tools": [{"id": "tool-1", "tool_type_id": "githubconsolidated", "state": "configured"}, {"id": "tool-2", "tool_type_id": "pipeline", "state": "misconfigured"}]}`)
+			default:
+				Fail("unexpected request: " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newCollector := func() *promcollector.Collector {
+		pipelineService, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		toolchainService, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		collector, err := promcollector.NewCollector(&promcollector.CollectorOptions{
+			PipelineClient:   pipelineService,
+			ToolchainClient:  toolchainService,
+			PipelineIDs:      []string{"pipeline-1"},
+			ResourceGroupIDs: []string{"rg-1"},
+		})
+		Expect(err).To(BeNil())
+		return collector
+	}
+
+	It(`Exposes the polled state`, func() {
+		collector := newCollector()
+		Expect(collector.Poll(context.Background())).To(Succeed())
+
+		registry := prometheus.NewPedanticRegistry()
+		Expect(registry.Register(collector)).To(Succeed())
+		expected := `
+# HELP cd_tekton_pipeline_build_number The latest pipeline run build number.
+# TYPE cd_tekton_pipeline_build_number gauge
+cd_tekton_pipeline_build_number{pipeline_id="pipeline-1"} 42
+# HELP cd_tekton_pipeline_runs Number of recent pipeline runs by status.
+# TYPE cd_tekton_pipeline_runs gauge
+cd_tekton_pipeline_runs{pipeline_id="pipeline-1",status="failed"} 1
+cd_tekton_pipeline_runs{pipeline_id="pipeline-1",status="running"} 1
+cd_tekton_pipeline_runs{pipeline_id="pipeline-1",status="succeeded"} 1
+# HELP cd_tekton_pipeline_trigger_enabled 1 if the trigger is enabled, 0 otherwise.
+# TYPE cd_tekton_pipeline_trigger_enabled gauge
+cd_tekton_pipeline_trigger_enabled{pipeline_id="pipeline-1",trigger="build"} 1
+cd_tekton_pipeline_trigger_enabled{pipeline_id="pipeline-1",trigger="deploy"} 0
+# HELP cd_toolchain_tool_state 1 for the current configuration state of each tool.
+# TYPE cd_toolchain_tool_state gauge
+cd_toolchain_tool_state{state="configured",tool_id="tool-1",tool_type_id="githubconsolidated",toolchain_id="toolchain-1"} 1
+cd_toolchain_tool_state{state="misconfigured",tool_id="tool-2",tool_type_id="pipeline",toolchain_id="toolchain-1"} 1
+`
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(expected),
+			"cd_tekton_pipeline_build_number", "cd_tekton_pipeline_runs", "cd_tekton_pipeline_trigger_enabled", "cd_toolchain_tool_state")).To(Succeed())
+		Expect(testutil.CollectAndCount(collector, "cd_tekton_pipeline_run_duration_seconds")).To(Equal(2))
+	})
+	It(`Observes each finished run once`, func() {
+		collector := newCollector()
+		Expect(collector.Poll(context.Background())).To(Succeed())
+		Expect(collector.Poll(context.Background())).To(Succeed())
+
+		registry := prometheus.NewRegistry()
+		Expect(registry.Register(collector)).To(Succeed())
+		families, err := registry.Gather()
+		Expect(err).To(BeNil())
+		var samples uint64
+		for _, family := range families {
+			if family.GetName() == "cd_tekton_pipeline_run_duration_seconds" {
+				for _, metric := range family.GetMetric() {
+					samples += metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+		Expect(samples).To(Equal(uint64(2)))
+	})
+	It(`Counts failed calls and keeps the previous state`, func() {
+		collector := newCollector()
+		Expect(collector.Poll(context.Background())).To(Succeed())
+		failRuns = true
+		Expect(collector.Poll(context.Background())).ToNot(Succeed())
+
+		Expect(testutil.CollectAndCount(collector, "cd_tekton_pipeline_runs")).To(Equal(3))
+		registry := prometheus.NewRegistry()
+		Expect(registry.Register(collector)).To(Succeed())
+		expected := `
+# HELP cd_last_poll_success 1 if every API call of the last poll succeeded, 0 otherwise.
+# TYPE cd_last_poll_success gauge
+cd_last_poll_success 0
+# HELP cd_poll_errors_total Number of failed API calls while polling.
+# TYPE cd_poll_errors_total counter
+cd_poll_errors_total 1
+`
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(expected), "cd_last_poll_success", "cd_poll_errors_total")).To(Succeed())
+	})
+	It(`Rejects missing clients`, func() {
+		collector, err := promcollector.NewCollector(&promcollector.CollectorOptions{PipelineIDs: []string{"pipeline-1"}})
+		Expect(err).ToNot(BeNil())
+		Expect(collector).To(BeNil())
+
+		collector, err = promcollector.NewCollector(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(collector).To(BeNil())
+	})
+})
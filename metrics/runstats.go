@@ -0,0 +1,261 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+)
+
+// RunTransition : A change of status of a pipeline run, as observed by a watcher.
+type RunTransition struct {
+	// The ID of the pipeline run.
+	RunID string
+
+	// The status before the transition, "" when the run was first observed.
+	From string
+
+	// The status after the transition.
+	To string
+
+	// When the transition was observed.
+	Time time.Time
+}
+
+// RunStats aggregates durations, outcome rates and queue times over a set of pipeline runs. Runs are added with Add
+// and status transitions with AddTransition; both are safe for concurrent use.
+type RunStats struct {
+	mutex       sync.Mutex
+	runs        map[string]*cdtektonpipelinev2.PipelineRun
+	order       []string
+	queuedSince map[string]time.Time
+	queueTimes  map[string]time.Duration
+}
+
+// RunStatsSummary : Statistics for all runs, and broken down by trigger, worker and listener.
+type RunStatsSummary struct {
+	// Statistics across every run.
+	Overall RunStatsGroup `json:"overall"`
+
+	// Statistics keyed by trigger name.
+	ByTrigger map[string]*RunStatsGroup `json:"by_trigger"`
+
+	// Statistics keyed by worker name.
+	ByWorker map[string]*RunStatsGroup `json:"by_worker"`
+
+	// Statistics keyed by the agent ID of private workers. Runs on shared workers have no agent ID and are omitted.
+	ByAgentID map[string]*RunStatsGroup `json:"by_agent_id"`
+
+	// Statistics keyed by listener name.
+	ByListener map[string]*RunStatsGroup `json:"by_listener"`
+}
+
+// RunStatsGroup : Statistics for a group of runs.
+type RunStatsGroup struct {
+	// Number of runs in the group.
+	Count int `json:"count"`
+
+	// Number of runs in each status.
+	Statuses map[string]int `json:"statuses"`
+
+	// Succeeded runs divided by terminal, non-cancelled runs.
+	SuccessRate float64 `json:"success_rate"`
+
+	// Failed runs divided by terminal, non-cancelled runs.
+	FailureRate float64 `json:"failure_rate"`
+
+	// Errored runs divided by terminal, non-cancelled runs.
+	ErrorRate float64 `json:"error_rate"`
+
+	// Percentiles of the time from creation to last update of terminal runs.
+	Duration Percentiles `json:"duration"`
+
+	// Percentiles of the estimated time spent queued before running. Only available for runs with observed transitions.
+	QueueTime Percentiles `json:"queue_time"`
+
+	durations  []time.Duration
+	queueTimes []time.Duration
+}
+
+// Percentiles : Percentiles of a set of durations.
+type Percentiles struct {
+	// Number of samples.
+	Samples int `json:"samples"`
+
+	// The 50th percentile.
+	P50 time.Duration `json:"p50"`
+
+	// The 90th percentile.
+	P90 time.Duration `json:"p90"`
+
+	// The 99th percentile.
+	P99 time.Duration `json:"p99"`
+}
+
+// NewRunStats returns an empty RunStats.
+func NewRunStats() *RunStats {
+	return &RunStats{
+		runs:        map[string]*cdtektonpipelinev2.PipelineRun{},
+		queuedSince: map[string]time.Time{},
+		queueTimes:  map[string]time.Duration{},
+	}
+}
+
+// Add adds runs to the aggregate. Adding a run whose ID was already added replaces the earlier copy.
+func (stats *RunStats) Add(runs ...cdtektonpipelinev2.PipelineRun) {
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+	for i := range runs {
+		run := runs[i]
+		if run.ID == nil {
+			continue
+		}
+		if _, ok := stats.runs[*run.ID]; !ok {
+			stats.order = append(stats.order, *run.ID)
+		}
+		stats.runs[*run.ID] = &run
+	}
+}
+
+// AddTransition records a status transition. The queue time of a run is estimated as the time from the first
+// transition into `pending`, `queued` or `waiting` until the first transition into `running` or a terminal status.
+func (stats *RunStats) AddTransition(transition RunTransition) {
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+	if _, done := stats.queueTimes[transition.RunID]; done {
+		return
+	}
+	switch transition.To {
+	case cdtektonpipelinev2.PipelineRunStatusPendingConst,
+		cdtektonpipelinev2.PipelineRunStatusQueuedConst,
+		cdtektonpipelinev2.PipelineRunStatusWaitingConst:
+		if _, ok := stats.queuedSince[transition.RunID]; !ok {
+			stats.queuedSince[transition.RunID] = transition.Time
+		}
+	default:
+		if since, ok := stats.queuedSince[transition.RunID]; ok {
+			stats.queueTimes[transition.RunID] = transition.Time.Sub(since)
+			delete(stats.queuedSince, transition.RunID)
+		}
+	}
+}
+
+// Summary computes the statistics for the runs added so far.
+func (stats *RunStats) Summary() *RunStatsSummary {
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+
+	summary := &RunStatsSummary{
+		Overall:    RunStatsGroup{Statuses: map[string]int{}},
+		ByTrigger:  map[string]*RunStatsGroup{},
+		ByWorker:   map[string]*RunStatsGroup{},
+		ByAgentID:  map[string]*RunStatsGroup{},
+		ByListener: map[string]*RunStatsGroup{},
+	}
+	groupFor := func(groups map[string]*RunStatsGroup, key string) *RunStatsGroup {
+		group, ok := groups[key]
+		if !ok {
+			group = &RunStatsGroup{Statuses: map[string]int{}}
+			groups[key] = group
+		}
+		return group
+	}
+
+	for _, id := range stats.order {
+		run := stats.runs[id]
+		groups := []*RunStatsGroup{
+			&summary.Overall,
+			groupFor(summary.ByTrigger, triggerName(run)),
+		}
+		if run.Worker != nil {
+			if run.Worker.Name != nil {
+				groups = append(groups, groupFor(summary.ByWorker, *run.Worker.Name))
+			}
+			if run.Worker.AgentID != nil && *run.Worker.AgentID != "" {
+				groups = append(groups, groupFor(summary.ByAgentID, *run.Worker.AgentID))
+			}
+		}
+		if run.ListenerName != nil {
+			groups = append(groups, groupFor(summary.ByListener, *run.ListenerName))
+		}
+		queueTime, hasQueueTime := stats.queueTimes[id]
+		for _, group := range groups {
+			group.add(run)
+			if hasQueueTime {
+				group.queueTimes = append(group.queueTimes, queueTime)
+			}
+		}
+	}
+
+	summary.Overall.summarize()
+	for _, groups := range []map[string]*RunStatsGroup{summary.ByTrigger, summary.ByWorker, summary.ByAgentID, summary.ByListener} {
+		for _, group := range groups {
+			group.summarize()
+		}
+	}
+	return summary
+}
+
+func (group *RunStatsGroup) add(run *cdtektonpipelinev2.PipelineRun) {
+	status := runStatus(run)
+	group.Count++
+	group.Statuses[status]++
+	if !cdtektonpipelinev2.IsTerminalPipelineRunStatus(status) || run.UpdatedAt == nil {
+		return
+	}
+	if created, finished, ok := runTimes(run); ok {
+		group.durations = append(group.durations, finished.Sub(created))
+	}
+}
+
+func (group *RunStatsGroup) summarize() {
+	completed := group.Statuses[cdtektonpipelinev2.PipelineRunStatusSucceededConst] +
+		group.Statuses[cdtektonpipelinev2.PipelineRunStatusFailedConst] +
+		group.Statuses[cdtektonpipelinev2.PipelineRunStatusErrorConst]
+	if completed > 0 {
+		group.SuccessRate = float64(group.Statuses[cdtektonpipelinev2.PipelineRunStatusSucceededConst]) / float64(completed)
+		group.FailureRate = float64(group.Statuses[cdtektonpipelinev2.PipelineRunStatusFailedConst]) / float64(completed)
+		group.ErrorRate = float64(group.Statuses[cdtektonpipelinev2.PipelineRunStatusErrorConst]) / float64(completed)
+	}
+	group.Duration = percentiles(group.durations)
+	group.QueueTime = percentiles(group.queueTimes)
+}
+
+// percentiles computes nearest-rank percentiles.
+func percentiles(values []time.Duration) (result Percentiles) {
+	result.Samples = len(values)
+	if len(values) == 0 {
+		return
+	}
+	sorted := append([]time.Duration(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := func(p float64) time.Duration {
+		index := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		if index < 0 {
+			index = 0
+		}
+		return sorted[index]
+	}
+	result.P50 = rank(50)
+	result.P90 = rank(90)
+	result.P99 = rank(99)
+	return
+}
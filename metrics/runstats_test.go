@@ -0,0 +1,89 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics_test
+
+import (
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/metrics"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RunStats`, func() {
+	withWorker := func(run cdtektonpipelinev2.PipelineRun, name string, agentID string, listener string) cdtektonpipelinev2.PipelineRun {
+		run.Worker = &cdtektonpipelinev2.PipelineRunWorker{Name: core.StringPtr(name), ID: core.StringPtr(name)}
+		if agentID != "" {
+			run.Worker.AgentID = core.StringPtr(agentID)
+		}
+		run.ListenerName = core.StringPtr(listener)
+		return run
+	}
+
+	It(`Computes durations, rates and counts`, func() {
+		stats := metrics.NewRunStats()
+		stats.Add(
+			withWorker(testRun("1", "build", "succeeded", "2025-03-03T09:00:00Z", "2025-03-03T09:01:00Z", ""), "public", "", "listener"),
+			withWorker(testRun("2", "build", "failed", "2025-03-03T09:00:00Z", "2025-03-03T09:02:00Z", ""), "private", "agent-1", "listener"),
+			withWorker(testRun("3", "build", "error", "2025-03-03T09:00:00Z", "2025-03-03T09:03:00Z", ""), "private", "agent-1", "listener"),
+			withWorker(testRun("4", "deploy", "succeeded", "2025-03-03T09:00:00Z", "2025-03-03T09:10:00Z", ""), "public", "", "deploy-listener"),
+			withWorker(testRun("5", "deploy", "running", "2025-03-03T09:00:00Z", "2025-03-03T09:10:00Z", ""), "public", "", "deploy-listener"),
+		)
+		summary := stats.Summary()
+
+		Expect(summary.Overall.Count).To(Equal(5))
+		Expect(summary.Overall.SuccessRate).To(Equal(0.5))
+		Expect(summary.Overall.FailureRate).To(Equal(0.25))
+		Expect(summary.Overall.ErrorRate).To(Equal(0.25))
+		Expect(summary.Overall.Duration.Samples).To(Equal(4))
+		Expect(summary.Overall.Duration.P50).To(Equal(2 * time.Minute))
+		Expect(summary.Overall.Duration.P90).To(Equal(10 * time.Minute))
+		Expect(summary.Overall.Duration.P99).To(Equal(10 * time.Minute))
+
+		Expect(summary.ByTrigger).To(HaveLen(2))
+		Expect(summary.ByTrigger["build"].Count).To(Equal(3))
+		Expect(summary.ByTrigger["deploy"].Statuses).To(Equal(map[string]int{"succeeded": 1, "running": 1}))
+		Expect(summary.ByWorker["private"].Count).To(Equal(2))
+		Expect(summary.ByAgentID).To(HaveLen(1))
+		Expect(summary.ByAgentID["agent-1"].SuccessRate).To(Equal(0.0))
+		Expect(summary.ByListener["deploy-listener"].Count).To(Equal(2))
+	})
+	It(`Replaces runs that are added twice`, func() {
+		stats := metrics.NewRunStats()
+		stats.Add(testRun("1", "build", "running", "2025-03-03T09:00:00Z", "", ""))
+		stats.Add(testRun("1", "build", "succeeded", "2025-03-03T09:00:00Z", "2025-03-03T09:05:00Z", ""))
+		summary := stats.Summary()
+		Expect(summary.Overall.Count).To(Equal(1))
+		Expect(summary.Overall.SuccessRate).To(Equal(1.0))
+	})
+	It(`Estimates queue time from transitions`, func() {
+		start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+		stats := metrics.NewRunStats()
+		stats.Add(testRun("1", "build", "succeeded", "2025-03-03T09:00:00Z", "2025-03-03T09:05:00Z", ""))
+		stats.AddTransition(metrics.RunTransition{RunID: "1", To: "pending", Time: start})
+		stats.AddTransition(metrics.RunTransition{RunID: "1", From: "pending", To: "queued", Time: start.Add(10 * time.Second)})
+		stats.AddTransition(metrics.RunTransition{RunID: "1", From: "queued", To: "running", Time: start.Add(30 * time.Second)})
+		stats.AddTransition(metrics.RunTransition{RunID: "1", From: "running", To: "succeeded", Time: start.Add(5 * time.Minute)})
+
+		summary := stats.Summary()
+		Expect(summary.Overall.QueueTime.Samples).To(Equal(1))
+		Expect(summary.Overall.QueueTime.P50).To(Equal(30 * time.Second))
+		Expect(summary.ByTrigger["build"].QueueTime.P99).To(Equal(30 * time.Second))
+	})
+})
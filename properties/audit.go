@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package properties
+
+import (
+	"context"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the SecretFinding.Kind property.
+// The problem found with a secure property.
+const (
+	SecretFindingKindLiteralConst          = "literal"
+	SecretFindingKindInvalidReferenceConst = "invalid_reference"
+)
+
+// SecretFinding : A secure property that does not hold a valid secret reference. It never holds the value of the
+// property.
+type SecretFinding struct {
+	// The ID of the pipeline.
+	PipelineID string `json:"pipeline_id"`
+
+	// The ID of the trigger, for trigger properties.
+	TriggerID string `json:"trigger_id,omitempty"`
+
+	// The name of the trigger, for trigger properties.
+	TriggerName string `json:"trigger_name,omitempty"`
+
+	// The name of the property.
+	Property string `json:"property"`
+
+	// The problem found with the property.
+	Kind string `json:"kind"`
+
+	// Why the reference is invalid, for invalid references.
+	Message string `json:"message,omitempty"`
+}
+
+// SecretAudit : The outcome of AuditSecureProperties.
+type SecretAudit struct {
+	// The number of secure properties checked.
+	Checked int `json:"checked"`
+
+	// The secure properties that do not hold a valid secret reference.
+	Findings []SecretFinding `json:"findings"`
+}
+
+// secureProperty : A secure property of a pipeline or of one of its triggers.
+type secureProperty struct {
+	PipelineID  string
+	TriggerID   string
+	TriggerName string
+	Name        string
+	Value       string
+	Locked      *bool
+}
+
+// AuditSecureProperties lists the secure pipeline and trigger properties of the specified pipelines that hold a
+// literal secret rather than a secret reference, and those whose reference is malformed or rejected by one of the
+// validators. Properties without a value are not reported.
+func AuditSecureProperties(ctx context.Context, client *cdtektonpipelinev2.CdTektonPipelineV2, pipelineIDs []string, validators ...SecretReferenceValidator) (audit *SecretAudit, err error) {
+	audit = &SecretAudit{Findings: []SecretFinding{}}
+	for _, pipelineID := range pipelineIDs {
+		var secure []secureProperty
+		if secure, err = listSecureProperties(ctx, client, pipelineID); err != nil {
+			audit = nil
+			return
+		}
+		for _, property := range secure {
+			if property.Value == "" {
+				continue
+			}
+			audit.Checked++
+			finding := SecretFinding{
+				PipelineID:  property.PipelineID,
+				TriggerID:   property.TriggerID,
+				TriggerName: property.TriggerName,
+				Property:    property.Name,
+			}
+			if !IsSecretReference(property.Value) {
+				finding.Kind = SecretFindingKindLiteralConst
+				audit.Findings = append(audit.Findings, finding)
+				continue
+			}
+			if _, validateErr := ValidateSecretReference(property.Value, validators...); validateErr != nil {
+				finding.Kind = SecretFindingKindInvalidReferenceConst
+				finding.Message = validateErr.Error()
+				audit.Findings = append(audit.Findings, finding)
+			}
+		}
+	}
+	return
+}
+
+// listSecureProperties returns the secure properties of a pipeline followed by those of each of its triggers.
+func listSecureProperties(ctx context.Context, client *cdtektonpipelinev2.CdTektonPipelineV2, pipelineID string) (secure []secureProperty, err error) {
+	properties, _, err := client.ListTektonPipelinePropertiesWithContext(ctx, client.NewListTektonPipelinePropertiesOptions(pipelineID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-properties-error")
+		return
+	}
+	for _, property := range properties.Properties {
+		if core.StringNilMapper(property.Type) == cdtektonpipelinev2.PropertyTypeSecureConst {
+			secure = append(secure, secureProperty{
+				PipelineID: pipelineID,
+				Name:       core.StringNilMapper(property.Name),
+				Value:      core.StringNilMapper(property.Value),
+				Locked:     property.Locked,
+			})
+		}
+	}
+
+	triggers, _, err := client.ListTektonPipelineTriggersWithContext(ctx, client.NewListTektonPipelineTriggersOptions(pipelineID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-triggers-error")
+		return
+	}
+	for _, triggerIntf := range triggers.Triggers {
+		trigger := cdtektonpipelinev2.AsTrigger(triggerIntf)
+		if trigger == nil || trigger.ID == nil {
+			continue
+		}
+		var triggerProperties *cdtektonpipelinev2.TriggerPropertiesCollection
+		triggerProperties, _, err = client.ListTektonPipelineTriggerPropertiesWithContext(ctx, client.NewListTektonPipelineTriggerPropertiesOptions(pipelineID, *trigger.ID))
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-trigger-properties-error")
+			return
+		}
+		for _, property := range triggerProperties.Properties {
+			if core.StringNilMapper(property.Type) == cdtektonpipelinev2.TriggerPropertyTypeSecureConst {
+				secure = append(secure, secureProperty{
+					PipelineID:  pipelineID,
+					TriggerID:   *trigger.ID,
+					TriggerName: core.StringNilMapper(trigger.Name),
+					Name:        core.StringNilMapper(property.Name),
+					Value:       core.StringNilMapper(property.Value),
+					Locked:      property.Locked,
+				})
+			}
+		}
+	}
+	return
+}
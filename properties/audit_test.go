@@ -0,0 +1,81 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package properties_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/properties"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Secure property audit`, func() {
+	var testServer *httptest.Server
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/tekton_pipelines/pipeline-1/properties":
+				fmt.Fprintf(res, "%s", `{"properties": [
+					{"name": "api-key", "type": "secure", "value": "{vault::sm.group.api-key}"},
+					{"name": "token", "type": "secure", "value": "ghp_literal"},
+					{"name": "unset", "type": "secure"},
+					{"name": "region", "type": "text", "value": "us-south"}]}`)
+			case "/tekton_pipelines/pipeline-1/triggers":
+				fmt.Fprintf(res, "%s", `{"triggers": [{"id": "trigger-1", "name": "nightly", "type": "manual", "event_listener": "listener"}]}`)
+			case "/tekton_pipelines/pipeline-1/triggers/trigger-1/properties":
+				fmt.Fprintf(res, "%s", `{"properties": [
+					{"name": "signing-key", "type": "secure", "value": "{vault::kp.signing-key}"},
+					{"name": "password", "type": "secure", "value": "hunter2"}]}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`AuditSecureProperties(ctx, client, pipelineIDs, validators...)`, func() {
+		It(`Lists literal secrets and rejected references without their values`, func() {
+			client, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			audit, err := properties.AuditSecureProperties(context.Background(), client, []string{"pipeline-1"}, properties.KnownIntegrations("sm"))
+			Expect(err).To(BeNil())
+			Expect(audit.Checked).To(Equal(4))
+			Expect(audit.Findings).To(Equal([]properties.SecretFinding{
+				{PipelineID: "pipeline-1", Property: "token", Kind: properties.SecretFindingKindLiteralConst},
+				{PipelineID: "pipeline-1", TriggerID: "trigger-1", TriggerName: "nightly", Property: "signing-key", Kind: properties.SecretFindingKindInvalidReferenceConst,
+					Message: "{vault::kp.signing-key} references the unknown integration kp"},
+				{PipelineID: "pipeline-1", TriggerID: "trigger-1", TriggerName: "nightly", Property: "password", Kind: properties.SecretFindingKindLiteralConst},
+			}))
+		})
+	})
+})
@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package properties : Resolves, validates and manages the values of Tekton pipeline properties
+package properties
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResolveIntegrationProperty returns the value that a run of the pipeline sees for an integration property. The value
+// of the property is the ID of a tool in the toolchain of the pipeline, and its path selects a value from the
+// parameters of that tool. Path segments are separated by dots, and array elements are selected either with an index
+// segment or with an [index] suffix, as in "repos[0].url" or "repos.0.url". The parameters of the tool are returned
+// when the path is empty.
+func ResolveIntegrationProperty(ctx context.Context, toolchainClient *cdtoolchainv2.CdToolchainV2, pipeline *cdtektonpipelinev2.TektonPipeline, property *cdtektonpipelinev2.Property) (interface{}, error) {
+	if err := core.ValidateNotNil(property, "property cannot be nil"); err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	return resolveIntegration(ctx, toolchainClient, pipeline, core.StringNilMapper(property.Name), core.StringNilMapper(property.Type), core.StringNilMapper(property.Value), core.StringNilMapper(property.Path))
+}
+
+// ResolveIntegrationTriggerProperty returns the value that a run of the pipeline sees for an integration trigger
+// property, in the same way as ResolveIntegrationProperty.
+func ResolveIntegrationTriggerProperty(ctx context.Context, toolchainClient *cdtoolchainv2.CdToolchainV2, pipeline *cdtektonpipelinev2.TektonPipeline, property *cdtektonpipelinev2.TriggerProperty) (interface{}, error) {
+	if err := core.ValidateNotNil(property, "property cannot be nil"); err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	return resolveIntegration(ctx, toolchainClient, pipeline, core.StringNilMapper(property.Name), core.StringNilMapper(property.Type), core.StringNilMapper(property.Value), core.StringNilMapper(property.Path))
+}
+
+func resolveIntegration(ctx context.Context, toolchainClient *cdtoolchainv2.CdToolchainV2, pipeline *cdtektonpipelinev2.TektonPipeline, name string, propertyType string, toolID string, path string) (interface{}, error) {
+	if err := core.ValidateNotNil(toolchainClient, "toolchainClient cannot be nil"); err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	if err := core.ValidateNotNil(pipeline, "pipeline cannot be nil"); err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	if propertyType != cdtektonpipelinev2.PropertyTypeIntegrationConst {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("property %s is a %s property, not an integration property", name, propertyType), "not-integration-property", common.GetComponentInfo())
+	}
+	if toolID == "" {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("integration property %s does not reference a tool", name), "missing-tool", common.GetComponentInfo())
+	}
+	if pipeline.Toolchain == nil || core.StringNilMapper(pipeline.Toolchain.ID) == "" {
+		return nil, core.SDKErrorf(nil, "the pipeline does not reference a toolchain", "missing-toolchain", common.GetComponentInfo())
+	}
+
+	tool, _, err := toolchainClient.GetToolByIDWithContext(ctx, toolchainClient.NewGetToolByIDOptions(*pipeline.Toolchain.ID, toolID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-tool-error")
+	}
+	value, err := evaluatePath(tool.Parameters, path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("integration property %s: tool %s: %s", name, toolID, err.Error()), "path-not-found", common.GetComponentInfo())
+	}
+	return value, nil
+}
+
+// evaluatePath selects the value at a dot notation path from tool parameters.
+func evaluatePath(parameters map[string]interface{}, path string) (interface{}, error) {
+	var value interface{} = parameters
+	if path == "" {
+		return value, nil
+	}
+	segments, err := splitPath(path)
+	if err != nil {
+		return nil, err
+	}
+	for i, segment := range segments {
+		at := "parameters"
+		if i > 0 {
+			at = joinPath(segments[:i])
+		}
+		switch current := value.(type) {
+		case map[string]interface{}:
+			if segment.index >= 0 {
+				return nil, fmt.Errorf("%s is an object, not an array", at)
+			}
+			next, ok := current[segment.key]
+			if !ok {
+				keys := make([]string, 0, len(current))
+				for key := range current {
+					keys = append(keys, key)
+				}
+				sort.Strings(keys)
+				return nil, fmt.Errorf("%s has no key %q (keys: %s)", at, segment.key, strings.Join(keys, ", "))
+			}
+			value = next
+		case []interface{}:
+			index := segment.index
+			if index < 0 {
+				parsed, parseErr := strconv.Atoi(segment.key)
+				if parseErr != nil || parsed < 0 {
+					return nil, fmt.Errorf("%s is an array, %q is not an index", at, segment.key)
+				}
+				index = parsed
+			}
+			if index >= len(current) {
+				return nil, fmt.Errorf("index %d is out of range for %s, which has %d elements", index, at, len(current))
+			}
+			value = current[index]
+		case nil:
+			return nil, fmt.Errorf("%s is null", at)
+		case float64:
+			return nil, fmt.Errorf("%s is a number, not an object or array", at)
+		case string:
+			return nil, fmt.Errorf("%s is a string, not an object or array", at)
+		case bool:
+			return nil, fmt.Errorf("%s is a boolean, not an object or array", at)
+		default:
+			return nil, fmt.Errorf("%s is a %T, not an object or array", at, current)
+		}
+	}
+	return value, nil
+}
+
+// pathSegment : A single step of a path, either a key or an array index.
+type pathSegment struct {
+	key   string
+	index int
+}
+
+// splitPath splits a dot notation path into keys and [index] suffixes.
+func splitPath(path string) (segments []pathSegment, err error) {
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		var indexes []int
+		if open := strings.Index(part, "["); open >= 0 {
+			key = part[:open]
+			for rest := part[open:]; rest != ""; {
+				end := strings.Index(rest, "]")
+				if !strings.HasPrefix(rest, "[") || end < 0 {
+					return nil, fmt.Errorf("invalid path %q: malformed index in %q", path, part)
+				}
+				index, parseErr := strconv.Atoi(rest[1:end])
+				if parseErr != nil || index < 0 {
+					return nil, fmt.Errorf("invalid path %q: %q is not an index", path, rest[1:end])
+				}
+				indexes = append(indexes, index)
+				rest = rest[end+1:]
+			}
+		}
+		if key == "" && len(indexes) == 0 {
+			return nil, fmt.Errorf("invalid path %q: empty segment", path)
+		}
+		if key != "" {
+			segments = append(segments, pathSegment{key: key, index: -1})
+		}
+		for _, index := range indexes {
+			segments = append(segments, pathSegment{index: index})
+		}
+	}
+	return
+}
+
+// joinPath formats path segments in dot notation.
+func joinPath(segments []pathSegment) string {
+	var path strings.Builder
+	for _, segment := range segments {
+		if segment.index >= 0 {
+			fmt.Fprintf(&path, "[%d]", segment.index)
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteByte('.')
+		}
+		path.WriteString(segment.key)
+	}
+	return path.String()
+}
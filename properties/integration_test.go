@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package properties_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/properties"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Integration properties`, func() {
+	var testServer *httptest.Server
+	var toolchainClient *cdtoolchainv2.CdToolchainV2
+	pipeline := &cdtektonpipelinev2.TektonPipeline{
+		Toolchain: &cdtektonpipelinev2.ToolchainReference{ID: core.StringPtr("toolchain-1")},
+	}
+	integration := func(path string) *cdtektonpipelinev2.Property {
+		return &cdtektonpipelinev2.Property{
+			Name:  core.StringPtr("repo"),
+			Type:  core.StringPtr(cdtektonpipelinev2.PropertyTypeIntegrationConst),
+			Value: core.StringPtr("tool-1"),
+			Path:  core.StringPtr(path),
+		}
+	}
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.EscapedPath() != "/toolchains/toolchain-1/tools/tool-1" {
+				res.WriteHeader(404)
+				fmt.Fprintf(res, "%s", `{"errors": [{"code": "not_found", "message": "tool not found"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"id": "tool-1", "tool_type_id": "githubconsolidated", "toolchain_id": "toolchain-1",
+				"parameters": {"repo_url": "https://github.com/org/app", "private_repo": true, "webhooks": [{"id": 7, "events": ["push", "pull_request"]}], "owner": null}}`)
+		}))
+		var err error
+		toolchainClient, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`ResolveIntegrationProperty(ctx, toolchainClient, pipeline, property)`, func() {
+		It(`Resolves keys and array indices`, func() {
+			value, err := properties.ResolveIntegrationProperty(context.Background(), toolchainClient, pipeline, integration("repo_url"))
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal("https://github.com/org/app"))
+			value, err = properties.ResolveIntegrationProperty(context.Background(), toolchainClient, pipeline, integration("webhooks[0].events[1]"))
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal("pull_request"))
+			value, err = properties.ResolveIntegrationProperty(context.Background(), toolchainClient, pipeline, integration("webhooks.0.id"))
+			Expect(err).To(BeNil())
+			Expect(value).To(BeNumerically("==", 7))
+		})
+		It(`Returns the tool parameters when the path is empty`, func() {
+			value, err := properties.ResolveIntegrationProperty(context.Background(), toolchainClient, pipeline, integration(""))
+			Expect(err).To(BeNil())
+			Expect(value).To(HaveKeyWithValue("private_repo", true))
+		})
+		It(`Returns precise errors for paths that do not exist`, func() {
+			for path, message := range map[string]string{
+				"repo":                    `parameters has no key "repo" (keys: owner, private_repo, repo_url, webhooks)`,
+				"webhooks[2]":             "index 2 is out of range for webhooks, which has 1 elements",
+				"webhooks.first":          `webhooks is an array, "first" is not an index`,
+				"webhooks[0][0]":          "webhooks[0] is an object, not an array",
+				"repo_url.host":           "repo_url is a string, not an object or array",
+				"webhooks[0].id.value":    "webhooks[0].id is a number, not an object or array",
+				"owner.name":              "owner is null",
+				"webhooks[x]":             `"x" is not an index`,
+				"webhooks..events":        "empty segment",
+				"webhooks[0].events[-1]":  `"-1" is not an index`,
+				"private_repo.visibility": "private_repo is a boolean",
+			} {
+				_, err := properties.ResolveIntegrationProperty(context.Background(), toolchainClient, pipeline, integration(path))
+				Expect(err).ToNot(BeNil(), path)
+				Expect(err.Error()).To(ContainSubstring(message), path)
+			}
+		})
+		It(`Rejects properties that are not integrations`, func() {
+			property := integration("repo_url")
+			property.Type = core.StringPtr(cdtektonpipelinev2.PropertyTypeTextConst)
+			_, err := properties.ResolveIntegrationProperty(context.Background(), toolchainClient, pipeline, property)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not an integration property"))
+		})
+		It(`Returns the error of a tool that cannot be fetched`, func() {
+			property := integration("repo_url")
+			property.Value = core.StringPtr("tool-2")
+			_, err := properties.ResolveIntegrationProperty(context.Background(), toolchainClient, pipeline, property)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("tool not found"))
+		})
+	})
+
+	Describe(`ResolveIntegrationTriggerProperty(ctx, toolchainClient, pipeline, property)`, func() {
+		It(`Resolves trigger properties`, func() {
+			value, err := properties.ResolveIntegrationTriggerProperty(context.Background(), toolchainClient, pipeline, &cdtektonpipelinev2.TriggerProperty{
+				Name:  core.StringPtr("repo"),
+				Type:  core.StringPtr(cdtektonpipelinev2.TriggerPropertyTypeIntegrationConst),
+				Value: core.StringPtr("tool-1"),
+				Path:  core.StringPtr("private_repo"),
+			})
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal(true))
+		})
+	})
+})
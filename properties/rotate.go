@@ -0,0 +1,297 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package properties
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the RotationRecord.MatchedBy property.
+// How a property was matched.
+const (
+	RotationMatchedByNameConst      = "name"
+	RotationMatchedByReferenceConst = "reference"
+)
+
+// Constants associated with the RotationRecord.Action property.
+// What was done to a property.
+const (
+	RotationActionUpdatedConst     = "updated"
+	RotationActionWouldUpdateConst = "would_update"
+	RotationActionFailedConst      = "failed"
+)
+
+// pipelineToolTypeID is the tool type of pipelines in a toolchain.
+const pipelineToolTypeID = "pipeline"
+
+// RotationScope : The pipelines searched by RotateSecureValue.
+type RotationScope struct {
+	// The client used to read and update pipeline properties.
+	PipelineClient *cdtektonpipelinev2.CdTektonPipelineV2
+
+	// The client used to discover pipelines from toolchains. Required when ToolchainIDs or ResourceGroupIDs are set.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// Search the Tekton pipelines of these toolchains.
+	ToolchainIDs []string
+
+	// Search the Tekton pipelines of every toolchain in these resource groups.
+	ResourceGroupIDs []string
+
+	// Search these pipelines as well.
+	PipelineIDs []string
+
+	// Record the properties that match without updating them.
+	DryRun bool
+}
+
+// SecureValueMatcher : Selects the secure properties updated by RotateSecureValue. A property matches if either its
+// name or its reference matches.
+type SecureValueMatcher struct {
+	// Match properties with one of these names.
+	Names []string
+
+	// Match properties that hold one of these secret references.
+	References []string
+}
+
+// RotationRecord : A secure property found by RotateSecureValue. It never holds the value of the property.
+type RotationRecord struct {
+	// When the property was updated or found.
+	Time time.Time `json:"time"`
+
+	// The ID of the toolchain the pipeline was discovered from, if any.
+	ToolchainID string `json:"toolchain_id,omitempty"`
+
+	// The ID of the pipeline.
+	PipelineID string `json:"pipeline_id"`
+
+	// The ID of the trigger, for trigger properties.
+	TriggerID string `json:"trigger_id,omitempty"`
+
+	// The name of the trigger, for trigger properties.
+	TriggerName string `json:"trigger_name,omitempty"`
+
+	// The name of the property.
+	Property string `json:"property"`
+
+	// How the property was matched.
+	MatchedBy string `json:"matched_by"`
+
+	// What was done to the property.
+	Action string `json:"action"`
+
+	// Why the update failed, with the old and new values redacted.
+	Error string `json:"error,omitempty"`
+}
+
+// RotationAudit : The audit trail of RotateSecureValue.
+type RotationAudit struct {
+	// Whether the properties were only found.
+	DryRun bool `json:"dry_run"`
+
+	// The IDs of the pipelines that were searched.
+	Pipelines []string `json:"pipelines"`
+
+	// The secure properties that matched.
+	Records []RotationRecord `json:"records"`
+}
+
+// RotateSecureValue replaces the value of every secure pipeline and trigger property in scope that matches the
+// matcher with a new value, keeping whether it is locked. Pipelines are discovered from the Tekton pipeline tools of
+// the toolchains in scope. A failing update does not stop the others; the audit trail holds a record for each
+// matching property and an error is returned if any update failed. Neither the old nor the new value is logged or
+// recorded.
+func RotateSecureValue(ctx context.Context, scope *RotationScope, matcher *SecureValueMatcher, newValue string) (audit *RotationAudit, err error) {
+	if err = core.ValidateNotNil(scope, "scope cannot be nil"); err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if err = core.ValidateNotNil(scope.PipelineClient, "scope.PipelineClient cannot be nil"); err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if matcher == nil || len(matcher.Names) == 0 && len(matcher.References) == 0 {
+		err = core.SDKErrorf(nil, "the matcher must specify property names or references", "missing-matcher", common.GetComponentInfo())
+		return
+	}
+	if newValue == "" {
+		err = core.SDKErrorf(nil, "the new value must not be empty", "missing-value", common.GetComponentInfo())
+		return
+	}
+	if IsSecretReference(newValue) {
+		if _, err = ParseSecretReference(newValue); err != nil {
+			return
+		}
+	}
+	references := map[string]bool{}
+	for _, value := range matcher.References {
+		reference, parseErr := ParseSecretReference(value)
+		if parseErr != nil {
+			err = parseErr
+			return
+		}
+		references[reference.String()] = true
+	}
+
+	pipelines, err := scope.pipelines(ctx)
+	if err != nil {
+		return
+	}
+
+	client := scope.PipelineClient
+	audit = &RotationAudit{DryRun: scope.DryRun, Pipelines: []string{}, Records: []RotationRecord{}}
+	failed := 0
+	for _, pipeline := range pipelines {
+		audit.Pipelines = append(audit.Pipelines, pipeline.pipelineID)
+		secure, listErr := listSecureProperties(ctx, client, pipeline.pipelineID)
+		if listErr != nil {
+			err = listErr
+			return
+		}
+		for _, property := range secure {
+			matchedBy := matcher.match(property, references)
+			if matchedBy == "" {
+				continue
+			}
+			record := RotationRecord{
+				Time:        time.Now().UTC(),
+				ToolchainID: pipeline.toolchainID,
+				PipelineID:  property.PipelineID,
+				TriggerID:   property.TriggerID,
+				TriggerName: property.TriggerName,
+				Property:    property.Name,
+				MatchedBy:   matchedBy,
+				Action:      RotationActionWouldUpdateConst,
+			}
+			if !scope.DryRun {
+				record.Action = RotationActionUpdatedConst
+				if updateErr := replaceSecureValue(ctx, client, property, newValue); updateErr != nil {
+					record.Action = RotationActionFailedConst
+					record.Error = cdtektonpipelinev2.NewRedactor(property.Value, newValue).Redact(updateErr.Error())
+					failed++
+				}
+			}
+			audit.Records = append(audit.Records, record)
+		}
+	}
+
+	if failed > 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%d of %d secure properties could not be updated", failed, len(audit.Records)), "rotate-secure-value-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// match returns how a secure property matches, or an empty string if it does not.
+func (matcher *SecureValueMatcher) match(property secureProperty, references map[string]bool) string {
+	for _, name := range matcher.Names {
+		if property.Name == name {
+			return RotationMatchedByNameConst
+		}
+	}
+	if IsSecretReference(property.Value) {
+		if reference, err := ParseSecretReference(property.Value); err == nil && references[reference.String()] {
+			return RotationMatchedByReferenceConst
+		}
+	}
+	return ""
+}
+
+// replaceSecureValue replaces the value of a secure pipeline or trigger property.
+func replaceSecureValue(ctx context.Context, client *cdtektonpipelinev2.CdTektonPipelineV2, property secureProperty, value string) (err error) {
+	if property.TriggerID == "" {
+		options := client.NewReplaceTektonPipelinePropertyOptions(property.PipelineID, property.Name, property.Name, cdtektonpipelinev2.PropertyTypeSecureConst).SetValue(value)
+		options.Locked = property.Locked
+		_, _, err = client.ReplaceTektonPipelinePropertyWithContext(ctx, options)
+		return
+	}
+	options := client.NewReplaceTektonPipelineTriggerPropertyOptions(property.PipelineID, property.TriggerID, property.Name, property.Name, cdtektonpipelinev2.TriggerPropertyTypeSecureConst).SetValue(value)
+	options.Locked = property.Locked
+	_, _, err = client.ReplaceTektonPipelineTriggerPropertyWithContext(ctx, options)
+	return
+}
+
+// scopedPipeline : A pipeline in scope and the toolchain it was discovered from.
+type scopedPipeline struct {
+	pipelineID  string
+	toolchainID string
+}
+
+// pipelines returns the pipelines in scope, each once.
+func (scope *RotationScope) pipelines(ctx context.Context) (pipelines []scopedPipeline, err error) {
+	seen := map[string]bool{}
+	for _, pipelineID := range scope.PipelineIDs {
+		if !seen[pipelineID] {
+			seen[pipelineID] = true
+			pipelines = append(pipelines, scopedPipeline{pipelineID: pipelineID})
+		}
+	}
+	if len(scope.ToolchainIDs) == 0 && len(scope.ResourceGroupIDs) == 0 {
+		return
+	}
+	if err = core.ValidateNotNil(scope.ToolchainClient, "scope.ToolchainClient cannot be nil"); err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	client := scope.ToolchainClient
+	toolchainIDs := append([]string{}, scope.ToolchainIDs...)
+	for _, resourceGroupID := range scope.ResourceGroupIDs {
+		pager, pagerErr := client.NewToolchainsPager(client.NewListToolchainsOptions(resourceGroupID))
+		if pagerErr != nil {
+			err = core.RepurposeSDKProblem(pagerErr, "toolchains-pager-error")
+			return
+		}
+		toolchains, listErr := pager.GetAllWithContext(ctx)
+		if listErr != nil {
+			err = core.RepurposeSDKProblem(listErr, "list-toolchains-error")
+			return
+		}
+		for _, toolchain := range toolchains {
+			if toolchain.ID != nil {
+				toolchainIDs = append(toolchainIDs, *toolchain.ID)
+			}
+		}
+	}
+	for _, toolchainID := range toolchainIDs {
+		pager, pagerErr := client.NewToolsPager(client.NewListToolsOptions(toolchainID))
+		if pagerErr != nil {
+			err = core.RepurposeSDKProblem(pagerErr, "tools-pager-error")
+			return
+		}
+		tools, listErr := pager.GetAllWithContext(ctx)
+		if listErr != nil {
+			err = core.RepurposeSDKProblem(listErr, "list-tools-error")
+			return
+		}
+		for _, tool := range tools {
+			if tool.ID == nil || core.StringNilMapper(tool.ToolTypeID) != pipelineToolTypeID || tool.Parameters["type"] != "tekton" || seen[*tool.ID] {
+				continue
+			}
+			seen[*tool.ID] = true
+			pipelines = append(pipelines, scopedPipeline{pipelineID: *tool.ID, toolchainID: toolchainID})
+		}
+	}
+	return
+}
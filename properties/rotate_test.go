@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package properties_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/properties"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Secure value rotation`, func() {
+	const newValue = "rotated-token-value"
+	var testServer *httptest.Server
+	var scope *properties.RotationScope
+	var updates map[string]map[string]interface{}
+	BeforeEach(func() {
+		updates = map[string]map[string]interface{}{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.EscapedPath()
+			if req.Method == "PUT" {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				if path == "/tekton_pipelines/pipeline-3/properties/deploy-key" {
+					res.WriteHeader(400)
+					fmt.Fprintf(res, `{"errors": [{"code": "bad_request", "message": "value %s was rejected"}]}`, body["value"])
+					return
+				}
+				updates[path] = body
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+				return
+			}
+			res.WriteHeader(200)
+			switch path {
+			case "/toolchains":
+				Expect(req.URL.Query().Get("resource_group_id")).To(Equal("group-1"))
+				fmt.Fprintf(res, "%s", `{"total_count": 1, "limit": 20, "first": {"href": "Href"}, "toolchains": [{"id": "toolchain-1"}]}`)
+			case "/toolchains/toolchain-1/tools":
+				fmt.Fprintf(res, "%s", `{"total_count": 3, "limit": 20, "first": {"href": "Href"}, "tools": [
+					{"id": "pipeline-1", "tool_type_id": "pipeline", "parameters": {"type": "tekton"}},
+					{"id": "pipeline-2", "tool_type_id": "pipeline", "parameters": {"type": "classic"}},
+					{"id": "repo-1", "tool_type_id": "githubconsolidated", "parameters": {}}]}`)
+			case "/tekton_pipelines/pipeline-1/properties":
+				fmt.Fprintf(res, "%s", `{"properties": [
+					{"name": "api-token", "type": "secure", "value": "old-token-value", "locked": true},
+					{"name": "other", "type": "secure", "value": "{vault::sm.group.other}"},
+					{"name": "api-token-url", "type": "text", "value": "https://example.com"}]}`)
+			case "/tekton_pipelines/pipeline-1/triggers":
+				fmt.Fprintf(res, "%s", `{"triggers": [{"id": "trigger-1", "name": "nightly", "type": "manual", "event_listener": "listener"}]}`)
+			case "/tekton_pipelines/pipeline-1/triggers/trigger-1/properties":
+				fmt.Fprintf(res, "%s", `{"properties": [{"name": "token", "type": "secure", "value": " {vault::sm.group.token} "}]}`)
+			case "/tekton_pipelines/pipeline-3/properties":
+				fmt.Fprintf(res, "%s", `{"properties": [{"name": "deploy-key", "type": "secure", "value": "{vault::sm.group.token}"}]}`)
+			case "/tekton_pipelines/pipeline-3/triggers":
+				fmt.Fprintf(res, "%s", `{"triggers": []}`)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+
+		pipelineClient, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		toolchainClient, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		scope = &properties.RotationScope{
+			PipelineClient:   pipelineClient,
+			ToolchainClient:  toolchainClient,
+			ResourceGroupIDs: []string{"group-1"},
+			PipelineIDs:      []string{"pipeline-3"},
+		}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	matcher := &properties.SecureValueMatcher{Names: []string{"api-token"}, References: []string{"{vault::sm.group.token}"}}
+	summary := func(audit *properties.RotationAudit) []string {
+		var records []string
+		for _, record := range audit.Records {
+			Expect(record.Time.IsZero()).To(BeFalse())
+			records = append(records, fmt.Sprintf("%s %s/%s/%s %s %s", record.ToolchainID, record.PipelineID, record.TriggerName, record.Property, record.MatchedBy, record.Action))
+		}
+		return records
+	}
+
+	Describe(`RotateSecureValue(ctx, scope, matcher, newValue)`, func() {
+		It(`Finds the matching properties of a dry run without updating them`, func() {
+			scope.DryRun = true
+			audit, err := properties.RotateSecureValue(context.Background(), scope, matcher, newValue)
+			Expect(err).To(BeNil())
+			Expect(audit.Pipelines).To(Equal([]string{"pipeline-3", "pipeline-1"}))
+			Expect(summary(audit)).To(Equal([]string{
+				" pipeline-3//deploy-key reference would_update",
+				"toolchain-1 pipeline-1//api-token name would_update",
+				"toolchain-1 pipeline-1/nightly/token reference would_update",
+			}))
+			Expect(updates).To(BeEmpty())
+		})
+		It(`Updates the matching properties and records failures without their values`, func() {
+			audit, err := properties.RotateSecureValue(context.Background(), scope, matcher, newValue)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("1 of 3 secure properties could not be updated"))
+			Expect(summary(audit)).To(Equal([]string{
+				" pipeline-3//deploy-key reference failed",
+				"toolchain-1 pipeline-1//api-token name updated",
+				"toolchain-1 pipeline-1/nightly/token reference updated",
+			}))
+			Expect(audit.Records[0].Error).To(ContainSubstring("value [REDACTED] was rejected"))
+			Expect(audit.Records[0].Error).ToNot(ContainSubstring(newValue))
+
+			Expect(updates).To(HaveLen(2))
+			Expect(updates["/tekton_pipelines/pipeline-1/properties/api-token"]).To(Equal(map[string]interface{}{
+				"name": "api-token", "type": "secure", "value": newValue, "locked": true,
+			}))
+			Expect(updates["/tekton_pipelines/pipeline-1/triggers/trigger-1/properties/token"]).To(HaveKeyWithValue("value", newValue))
+
+			trail, err := json.Marshal(audit)
+			Expect(err).To(BeNil())
+			Expect(string(trail)).ToNot(ContainSubstring(newValue))
+			Expect(string(trail)).ToNot(ContainSubstring("old-token-value"))
+		})
+		It(`Requires a matcher and a valid new value`, func() {
+			_, err := properties.RotateSecureValue(context.Background(), scope, &properties.SecureValueMatcher{}, newValue)
+			Expect(err).ToNot(BeNil())
+			_, err = properties.RotateSecureValue(context.Background(), scope, matcher, "")
+			Expect(err).ToNot(BeNil())
+			_, err = properties.RotateSecureValue(context.Background(), scope, matcher, "{vault::broken")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,248 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package properties
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Constants associated with the SecretReference.Kind property.
+// The syntax of a secret reference.
+const (
+	SecretReferenceKindVaultConst = "vault"
+	SecretReferenceKindCRNConst   = "crn"
+)
+
+// vaultPrefix and vaultSuffix enclose a vault reference.
+const (
+	vaultPrefix = "{vault::"
+	vaultSuffix = "}"
+)
+
+// SecretReference : A reference to a secret held by a secrets integration, used as the value of a secure property
+// instead of the secret itself.
+//
+// A vault reference has the form {vault::integration.secret}, as used for Key Protect keys, or
+// {vault::integration.group.secret}, as used for Secrets Manager secrets, where integration is the name of the tool
+// in the toolchain. A CRN reference is the CRN of the secret, such as
+// crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:id.
+type SecretReference struct {
+	// The syntax of the reference.
+	Kind string
+
+	// The name of the secrets integration, for vault references.
+	Integration string
+
+	// The secret group, for vault references that have one.
+	Group string
+
+	// The name of the secret, for vault references.
+	Secret string
+
+	// The service name segment, for CRN references.
+	Service string
+
+	// The location segment, for CRN references.
+	Location string
+
+	// The scope segment, for CRN references.
+	Scope string
+
+	// The service instance segment, for CRN references.
+	Instance string
+
+	// The resource type segment, for CRN references.
+	ResourceType string
+
+	// The resource segment, for CRN references.
+	Resource string
+
+	// The reference as it was parsed.
+	Raw string
+}
+
+// IsSecretReference returns true if a value uses one of the secret reference syntaxes, whether or not it is a valid
+// reference.
+func IsSecretReference(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, vaultPrefix) || strings.HasPrefix(value, "crn:")
+}
+
+// ParseSecretReference parses a vault or CRN secret reference.
+func ParseSecretReference(value string) (*SecretReference, error) {
+	trimmed := strings.TrimSpace(value)
+	switch {
+	case strings.HasPrefix(trimmed, vaultPrefix):
+		return parseVaultReference(trimmed)
+	case strings.HasPrefix(trimmed, "crn:"):
+		return parseCRNReference(trimmed)
+	}
+	return nil, core.SDKErrorf(nil, "the value is not a secret reference", "not-secret-reference", common.GetComponentInfo())
+}
+
+func parseVaultReference(value string) (*SecretReference, error) {
+	if !strings.HasSuffix(value, vaultSuffix) {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid vault reference %s: missing closing brace", value), "invalid-secret-reference", common.GetComponentInfo())
+	}
+	segments := strings.Split(strings.TrimSuffix(strings.TrimPrefix(value, vaultPrefix), vaultSuffix), ".")
+	if len(segments) < 2 || len(segments) > 3 {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid vault reference %s: expected integration.secret or integration.group.secret", value), "invalid-secret-reference", common.GetComponentInfo())
+	}
+	for _, segment := range segments {
+		if segment == "" || strings.TrimSpace(segment) != segment || strings.ContainsAny(segment, "{}:") {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid vault reference %s: invalid segment %q", value, segment), "invalid-secret-reference", common.GetComponentInfo())
+		}
+	}
+	reference := &SecretReference{Kind: SecretReferenceKindVaultConst, Integration: segments[0], Secret: segments[len(segments)-1], Raw: value}
+	if len(segments) == 3 {
+		reference.Group = segments[1]
+	}
+	return reference, nil
+}
+
+func parseCRNReference(value string) (*SecretReference, error) {
+	segments := strings.Split(value, ":")
+	if len(segments) != 10 || segments[1] != "v1" {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid CRN %s: expected crn:v1:cname:ctype:service:location:scope:instance:type:resource", value), "invalid-secret-reference", common.GetComponentInfo())
+	}
+	for i, name := range map[int]string{2: "cname", 3: "ctype", 4: "service name", 7: "service instance", 9: "resource"} {
+		if segments[i] == "" {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid CRN %s: the %s is empty", value, name), "invalid-secret-reference", common.GetComponentInfo())
+		}
+	}
+	return &SecretReference{
+		Kind:         SecretReferenceKindCRNConst,
+		Service:      segments[4],
+		Location:     segments[5],
+		Scope:        segments[6],
+		Instance:     segments[7],
+		ResourceType: segments[8],
+		Resource:     segments[9],
+		Raw:          value,
+	}, nil
+}
+
+// String returns the reference in its canonical form.
+func (reference *SecretReference) String() string {
+	if reference.Kind == SecretReferenceKindCRNConst {
+		return reference.Raw
+	}
+	segments := []string{reference.Integration, reference.Secret}
+	if reference.Group != "" {
+		segments = []string{reference.Integration, reference.Group, reference.Secret}
+	}
+	return vaultPrefix + strings.Join(segments, ".") + vaultSuffix
+}
+
+// SecretReferenceValidator : A check applied to a parsed secret reference by ValidateSecretReference.
+type SecretReferenceValidator func(reference *SecretReference) error
+
+// ValidateSecretReference parses a secret reference and applies each validator to it, returning the first error.
+func ValidateSecretReference(value string, validators ...SecretReferenceValidator) (*SecretReference, error) {
+	reference, err := ParseSecretReference(value)
+	if err != nil {
+		return nil, err
+	}
+	for _, validator := range validators {
+		if err = validator(reference); err != nil {
+			return nil, err
+		}
+	}
+	return reference, nil
+}
+
+// KnownIntegrations returns a validator that only accepts vault references to one of the named integrations.
+func KnownIntegrations(names ...string) SecretReferenceValidator {
+	return func(reference *SecretReference) error {
+		if reference.Kind != SecretReferenceKindVaultConst {
+			return nil
+		}
+		for _, name := range names {
+			if reference.Integration == name {
+				return nil
+			}
+		}
+		return core.SDKErrorf(nil, fmt.Sprintf("%s references the unknown integration %s", reference, reference.Integration), "unknown-integration", common.GetComponentInfo())
+	}
+}
+
+// AllowedCRNServices returns a validator that only accepts CRN references to one of the named services, such as
+// secrets-manager or kms.
+func AllowedCRNServices(services ...string) SecretReferenceValidator {
+	return func(reference *SecretReference) error {
+		if reference.Kind != SecretReferenceKindCRNConst {
+			return nil
+		}
+		for _, service := range services {
+			if reference.Service == service {
+				return nil
+			}
+		}
+		return core.SDKErrorf(nil, fmt.Sprintf("%s references the service %s, which is not allowed", reference, reference.Service), "disallowed-service", common.GetComponentInfo())
+	}
+}
+
+// SecretResolver : Resolves secret references to the secrets they reference.
+type SecretResolver interface {
+	// ResolveSecret returns the secret that a reference points to.
+	ResolveSecret(ctx context.Context, reference *SecretReference) (string, error)
+}
+
+// FileSecretResolver : A SecretResolver that reads secrets from a local file, for tests and local runs.
+type FileSecretResolver struct {
+	secrets map[string]string
+}
+
+// NewFileSecretResolver reads a YAML or JSON file that maps secret references, in their canonical form, to secrets.
+func NewFileSecretResolver(path string) (*FileSecretResolver, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("could not read secrets file %s: %s", path, err.Error()), "read-secrets-error", common.GetComponentInfo())
+	}
+	secrets := map[string]string{}
+	if err = yaml.Unmarshal(data, &secrets); err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("could not parse secrets file %s: %s", path, err.Error()), "parse-secrets-error", common.GetComponentInfo())
+	}
+	resolver := &FileSecretResolver{secrets: map[string]string{}}
+	for key, secret := range secrets {
+		reference, err := ParseSecretReference(key)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("secrets file %s: %s", path, err.Error()), "parse-secrets-error", common.GetComponentInfo())
+		}
+		resolver.secrets[reference.String()] = secret
+	}
+	return resolver, nil
+}
+
+// ResolveSecret returns the secret stored in the file for a reference.
+func (resolver *FileSecretResolver) ResolveSecret(ctx context.Context, reference *SecretReference) (string, error) {
+	if err := core.ValidateNotNil(reference, "reference cannot be nil"); err != nil {
+		return "", core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	secret, ok := resolver.secrets[reference.String()]
+	if !ok {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("secret %s not found", reference), "secret-not-found", common.GetComponentInfo())
+	}
+	return secret, nil
+}
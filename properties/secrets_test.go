@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package properties_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/properties"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Secret references`, func() {
+	const secretCRN = "crn:v1:bluemix:public:secrets-manager:us-south:a/account-1:instance-1:secret:secret-1"
+
+	Describe(`ParseSecretReference(value)`, func() {
+		It(`Parses vault references with and without a secret group`, func() {
+			reference, err := properties.ParseSecretReference("{vault::sm-compliance.ci-secrets.api-key}")
+			Expect(err).To(BeNil())
+			Expect(reference.Kind).To(Equal(properties.SecretReferenceKindVaultConst))
+			Expect(reference.Integration).To(Equal("sm-compliance"))
+			Expect(reference.Group).To(Equal("ci-secrets"))
+			Expect(reference.Secret).To(Equal("api-key"))
+
+			reference, err = properties.ParseSecretReference(" {vault::kp.signing-key} ")
+			Expect(err).To(BeNil())
+			Expect(reference.Group).To(BeEmpty())
+			Expect(reference.String()).To(Equal("{vault::kp.signing-key}"))
+		})
+		It(`Parses CRN references`, func() {
+			reference, err := properties.ParseSecretReference(secretCRN)
+			Expect(err).To(BeNil())
+			Expect(reference.Kind).To(Equal(properties.SecretReferenceKindCRNConst))
+			Expect(reference.Service).To(Equal("secrets-manager"))
+			Expect(reference.Instance).To(Equal("instance-1"))
+			Expect(reference.Resource).To(Equal("secret-1"))
+			Expect(reference.String()).To(Equal(secretCRN))
+		})
+		It(`Rejects malformed references and literals`, func() {
+			for _, value := range []string{
+				"{vault::sm.group.name",
+				"{vault::sm}",
+				"{vault::a.b.c.d}",
+				"{vault::sm..name}",
+				"crn:v1:bluemix:public:secrets-manager:us-south",
+				"crn:v1:bluemix:public::us-south:a/account-1:instance-1:secret:secret-1",
+				"hunter2",
+			} {
+				_, err := properties.ParseSecretReference(value)
+				Expect(err).ToNot(BeNil(), value)
+			}
+			Expect(properties.IsSecretReference("{vault::sm}")).To(BeTrue())
+			Expect(properties.IsSecretReference("hunter2")).To(BeFalse())
+		})
+	})
+
+	Describe(`ValidateSecretReference(value, validators...)`, func() {
+		It(`Applies the validators to the parsed reference`, func() {
+			validators := []properties.SecretReferenceValidator{properties.KnownIntegrations("sm"), properties.AllowedCRNServices("secrets-manager")}
+			_, err := properties.ValidateSecretReference("{vault::sm.group.name}", validators...)
+			Expect(err).To(BeNil())
+			_, err = properties.ValidateSecretReference(secretCRN, validators...)
+			Expect(err).To(BeNil())
+			_, err = properties.ValidateSecretReference("{vault::kp.key}", validators...)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("unknown integration kp"))
+			_, err = properties.ValidateSecretReference("crn:v1:bluemix:public:kms:us-south:a/account-1:instance-1:key:key-1", validators...)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("service kms"))
+		})
+	})
+
+	Describe(`FileSecretResolver`, func() {
+		It(`Resolves references from a file`, func() {
+			dir, err := os.MkdirTemp("", "secrets")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			file := filepath.Join(dir, "secrets.yaml")
+			Expect(os.WriteFile(file, []byte("'{vault::sm.group.api-key}': s3cret\n'"+secretCRN+"': other\n"), 0o600)).To(Succeed())
+
+			var resolver properties.SecretResolver
+			resolver, err = properties.NewFileSecretResolver(file)
+			Expect(err).To(BeNil())
+			reference, err := properties.ParseSecretReference("{vault::sm.group.api-key}")
+			Expect(err).To(BeNil())
+			Expect(resolver.ResolveSecret(context.Background(), reference)).To(Equal("s3cret"))
+			reference, err = properties.ParseSecretReference(secretCRN)
+			Expect(err).To(BeNil())
+			Expect(resolver.ResolveSecret(context.Background(), reference)).To(Equal("other"))
+			reference, err = properties.ParseSecretReference("{vault::sm.missing}")
+			Expect(err).To(BeNil())
+			_, err = resolver.ResolveSecret(context.Background(), reference)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not found"))
+		})
+		It(`Rejects files with keys that are not references`, func() {
+			dir, err := os.MkdirTemp("", "secrets")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			file := filepath.Join(dir, "secrets.json")
+			Expect(os.WriteFile(file, []byte(`{"api-key": "s3cret"}`), 0o600)).To(Succeed())
+			_, err = properties.NewFileSecretResolver(file)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
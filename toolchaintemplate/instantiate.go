@@ -0,0 +1,264 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toolchaintemplate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreatedResource : The identifiers of a toolchain or tool created by Instantiate.
+type CreatedResource struct {
+	// The ID of the resource.
+	ID string `json:"id"`
+
+	// The CRN of the resource.
+	CRN string `json:"crn"`
+}
+
+// Result : The resources created by Instantiate.
+type Result struct {
+	// The created toolchain.
+	Toolchain CreatedResource `json:"toolchain"`
+
+	// The created tools, by their name in the template.
+	Tools map[string]CreatedResource `json:"tools"`
+}
+
+// Instantiate creates the toolchain of a template and then its tools in dependency order, replacing the references in
+// tool parameters with the IDs and CRNs of the created resources. The Tekton pipeline of a pipeline tool is created and
+// configured with its definitions and properties right after the tool, with pipelineClient, which may be nil when the
+// template has no Tekton pipeline. If any step fails, the pipelines and tools already created are deleted in reverse
+// order, the toolchain is deleted and the error is returned. If the rollback fails too, the error has the
+// `rollback-error` discriminator and the result holds the toolchain and the tools that could not be deleted.
+func Instantiate(ctx context.Context, client *cdtoolchainv2.CdToolchainV2, pipelineClient *cdtektonpipelinev2.CdTektonPipelineV2, template *Template) (result *Result, err error) {
+	if err = core.ValidateNotNil(client, "client cannot be nil"); err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if err = core.ValidateNotNil(template, "template cannot be nil"); err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if err = template.Validate(); err != nil {
+		return
+	}
+	tools, err := template.order()
+	if err != nil {
+		return
+	}
+	for _, tool := range tools {
+		if tool.tekton() {
+			if err = core.ValidateNotNil(pipelineClient, "pipelineClient cannot be nil"); err != nil {
+				err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+				return
+			}
+		}
+	}
+
+	toolchainOptions := client.NewCreateToolchainOptions(template.Name, template.ResourceGroupID)
+	if template.Description != "" {
+		toolchainOptions.SetDescription(template.Description)
+	}
+	toolchain, _, err := client.CreateToolchainWithContext(ctx, toolchainOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-toolchain-error")
+		return
+	}
+	result = &Result{
+		Toolchain: CreatedResource{ID: core.StringNilMapper(toolchain.ID), CRN: core.StringNilMapper(toolchain.CRN)},
+		Tools:     map[string]CreatedResource{},
+	}
+
+	created := &createdResources{}
+	fail := func(cause error) {
+		err = cause
+		if rollbackErr := rollback(context.WithoutCancel(ctx), client, pipelineClient, result, created); rollbackErr != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("%s; the rollback also failed: %s", err.Error(), rollbackErr.Error()), "rollback-error", common.GetComponentInfo())
+			return
+		}
+		result = nil
+	}
+	for _, tool := range tools {
+		toolOptions := client.NewCreateToolOptions(result.Toolchain.ID, tool.ToolTypeID).SetName(tool.Name)
+		if tool.Parameters != nil {
+			toolOptions.SetParameters(substitute(tool.Parameters, result).(map[string]interface{}))
+		}
+		createdTool, _, createErr := client.CreateToolWithContext(ctx, toolOptions)
+		if createErr != nil {
+			fail(core.SDKErrorf(createErr, fmt.Sprintf("could not create tool %s: %s", tool.Name, createErr.Error()), "create-tool-error", common.GetComponentInfo()))
+			return
+		}
+		result.Tools[tool.Name] = CreatedResource{ID: core.StringNilMapper(createdTool.ID), CRN: core.StringNilMapper(createdTool.CRN)}
+		created.tools = append(created.tools, tool.Name)
+		if tool.tekton() {
+			if configureErr := configurePipeline(ctx, pipelineClient, tool, result, created); configureErr != nil {
+				fail(core.SDKErrorf(configureErr, fmt.Sprintf("could not configure the pipeline of tool %s: %s", tool.Name, configureErr.Error()), "configure-pipeline-error", common.GetComponentInfo()))
+				return
+			}
+		}
+	}
+	return
+}
+
+// createdResources : The tools and Tekton pipelines created so far, for the rollback.
+type createdResources struct {
+	// The names of the created tools, in creation order.
+	tools []string
+
+	// The names of the tools whose Tekton pipeline was created.
+	pipelines map[string]bool
+}
+
+// configurePipeline creates the Tekton pipeline of a pipeline tool, whose ID is that of the tool, and then its
+// definitions and properties, replacing the references in their strings.
+func configurePipeline(ctx context.Context, pipelineClient *cdtektonpipelinev2.CdTektonPipelineV2, tool *Tool, result *Result, created *createdResources) error {
+	pipeline := tool.Pipeline
+	if pipeline == nil {
+		pipeline = &Pipeline{}
+	}
+	pipelineID := result.Tools[tool.Name].ID
+	pipelineOptions := pipelineClient.NewCreateTektonPipelineOptions(pipelineID)
+	if pipeline.Worker != "" {
+		pipelineOptions.SetWorker(&cdtektonpipelinev2.WorkerIdentity{ID: core.StringPtr(substituteString(pipeline.Worker, result))})
+	}
+	if pipeline.NextBuildNumber != nil {
+		pipelineOptions.SetNextBuildNumber(*pipeline.NextBuildNumber)
+	}
+	if pipeline.EnableNotifications != nil {
+		pipelineOptions.SetEnableNotifications(*pipeline.EnableNotifications)
+	}
+	if _, _, err := pipelineClient.CreateTektonPipelineWithContext(ctx, pipelineOptions); err != nil {
+		return err
+	}
+	if created.pipelines == nil {
+		created.pipelines = map[string]bool{}
+	}
+	created.pipelines[tool.Name] = true
+
+	for _, definition := range pipeline.Definitions {
+		properties := &cdtektonpipelinev2.DefinitionSourceProperties{
+			URL:  core.StringPtr(substituteString(definition.URL, result)),
+			Path: core.StringPtr(substituteString(definition.Path, result)),
+		}
+		if definition.Branch != "" {
+			properties.Branch = core.StringPtr(substituteString(definition.Branch, result))
+		}
+		if definition.Tag != "" {
+			properties.Tag = core.StringPtr(substituteString(definition.Tag, result))
+		}
+		if definition.Tool != "" {
+			properties.Tool = &cdtektonpipelinev2.Tool{ID: core.StringPtr(substituteString(definition.Tool, result))}
+		}
+		source := &cdtektonpipelinev2.DefinitionSource{Type: core.StringPtr("git"), Properties: properties}
+		if _, _, err := pipelineClient.CreateTektonPipelineDefinitionWithContext(ctx, pipelineClient.NewCreateTektonPipelineDefinitionOptions(pipelineID, source)); err != nil {
+			return err
+		}
+	}
+	for _, property := range pipeline.Properties {
+		propertyOptions := pipelineClient.NewCreateTektonPipelinePropertiesOptions(pipelineID, property.Name, property.Type)
+		if property.Value != "" {
+			propertyOptions.SetValue(substituteString(property.Value, result))
+		}
+		if len(property.Enum) > 0 {
+			propertyOptions.SetEnum(property.Enum)
+		}
+		if property.Locked {
+			propertyOptions.SetLocked(true)
+		}
+		if property.Path != "" {
+			propertyOptions.SetPath(substituteString(property.Path, result))
+		}
+		if _, _, err := pipelineClient.CreateTektonPipelinePropertiesWithContext(ctx, propertyOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollback deletes the created tools in reverse order, each after its Tekton pipeline, and then the toolchain,
+// continuing past failures. The deleted tools are removed from the result.
+func rollback(ctx context.Context, client *cdtoolchainv2.CdToolchainV2, pipelineClient *cdtektonpipelinev2.CdTektonPipelineV2, result *Result, created *createdResources) error {
+	var failed []string
+	for i := len(created.tools) - 1; i >= 0; i-- {
+		tool := result.Tools[created.tools[i]]
+		deleted := true
+		if created.pipelines[created.tools[i]] {
+			if _, err := pipelineClient.DeleteTektonPipelineWithContext(ctx, pipelineClient.NewDeleteTektonPipelineOptions(tool.ID)); err != nil {
+				failed = append(failed, fmt.Sprintf("pipeline %s (%s): %s", created.tools[i], tool.ID, err.Error()))
+				deleted = false
+			}
+		}
+		if _, err := client.DeleteToolWithContext(ctx, client.NewDeleteToolOptions(result.Toolchain.ID, tool.ID)); err != nil {
+			failed = append(failed, fmt.Sprintf("tool %s (%s): %s", created.tools[i], tool.ID, err.Error()))
+			deleted = false
+		}
+		if deleted {
+			delete(result.Tools, created.tools[i])
+		}
+	}
+	if _, err := client.DeleteToolchainWithContext(ctx, client.NewDeleteToolchainOptions(result.Toolchain.ID)); err != nil {
+		failed = append(failed, fmt.Sprintf("toolchain %s: %s", result.Toolchain.ID, err.Error()))
+	}
+	if len(failed) > 0 {
+		return core.SDKErrorf(nil, "could not delete "+strings.Join(failed, "; "), "rollback-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// substitute returns a copy of a parameter value with the references in its strings replaced. The template has been
+// validated, so every reference names a created resource.
+func substitute(value interface{}, result *Result) interface{} {
+	switch typed := value.(type) {
+	case string:
+		return substituteString(typed, result)
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(typed))
+		for key, nested := range typed {
+			copied[key] = substitute(nested, result)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(typed))
+		for i, nested := range typed {
+			copied[i] = substitute(nested, result)
+		}
+		return copied
+	}
+	return value
+}
+
+// substituteString returns a string with its references replaced.
+func substituteString(value string, result *Result) string {
+	return referencePattern.ReplaceAllStringFunc(value, func(match string) string {
+		tool, field, _ := parseReference(referencePattern.FindStringSubmatch(match)[1])
+		resource := result.Toolchain
+		if tool != "" {
+			resource = result.Tools[tool]
+		}
+		if field == "crn" {
+			return resource.CRN
+		}
+		return resource.ID
+	})
+}
@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toolchaintemplate_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/toolchaintemplate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Instantiate(ctx, client, pipelineClient, template)`, func() {
+	var testServer *httptest.Server
+	var client *cdtoolchainv2.CdToolchainV2
+	var pipelineClient *cdtektonpipelinev2.CdTektonPipelineV2
+	var calls []string
+	var parameters map[string]map[string]interface{}
+	var pipelineBodies []map[string]interface{}
+	var failType string
+	var failProperty string
+	var failDelete string
+	BeforeEach(func() {
+		calls, parameters, pipelineBodies, failType, failProperty, failDelete = nil, map[string]map[string]interface{}{}, nil, "", "", ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			var body map[string]interface{}
+			if req.Method == "POST" {
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			}
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "POST /toolchains":
+				calls = append(calls, "create toolchain "+body["name"].(string))
+				Expect(body).To(HaveKeyWithValue("resource_group_id", "group-1"))
+				res.WriteHeader(201)
+				fmt.Fprintf(res, "%s", `{"id": "toolchain-1", "crn": "crn:toolchain-1"}`)
+			case "POST /toolchains/toolchain-1/tools":
+				name := body["name"].(string)
+				calls = append(calls, "create tool "+name)
+				if body["tool_type_id"] == failType {
+					res.WriteHeader(400)
+					fmt.Fprintf(res, "%s", `{"errors": [{"code": "bad_request", "message": "invalid parameters"}]}`)
+					return
+				}
+				if tool, ok := body["parameters"].(map[string]interface{}); ok {
+					parameters[name] = tool
+				}
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "%s-id", "crn": "crn:%s"}`, name, name)
+			case "POST /tekton_pipelines":
+				calls = append(calls, "create pipeline "+body["id"].(string))
+				pipelineBodies = append(pipelineBodies, body)
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "%s"}`, body["id"])
+			case "POST /tekton_pipelines/pipeline-id/definitions":
+				calls = append(calls, "create definition")
+				pipelineBodies = append(pipelineBodies, body)
+				res.WriteHeader(201)
+				fmt.Fprintf(res, "%s", `{"id": "definition-1"}`)
+			case "POST /tekton_pipelines/pipeline-id/properties":
+				calls = append(calls, "create property "+body["name"].(string))
+				if body["name"] == failProperty {
+					res.WriteHeader(400)
+					fmt.Fprintf(res, "%s", `{"errors": [{"code": "bad_request", "message": "invalid property"}]}`)
+					return
+				}
+				pipelineBodies = append(pipelineBodies, body)
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"name": "%s", "type": "%s"}`, body["name"], body["type"])
+			case "DELETE /toolchains/toolchain-1/tools/repo-id", "DELETE /toolchains/toolchain-1/tools/secrets-id", "DELETE /toolchains/toolchain-1/tools/pipeline-id",
+				"DELETE /toolchains/toolchain-1", "DELETE /tekton_pipelines/pipeline-id":
+				calls = append(calls, "delete "+req.URL.EscapedPath())
+				if failDelete != "" && strings.HasPrefix(req.URL.EscapedPath(), failDelete) {
+					res.WriteHeader(500)
+					fmt.Fprintf(res, "%s", `{"errors": [{"code": "internal_error", "message": "delete failed"}]}`)
+					return
+				}
+				res.WriteHeader(204)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var err error
+		client, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		pipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Creates the toolchain and its tools in dependency order with substituted references`, func() {
+		template, err := toolchaintemplate.Parse([]byte(templateYAML))
+		Expect(err).To(BeNil())
+		result, err := toolchaintemplate.Instantiate(context.Background(), client, pipelineClient, template)
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{
+			"create toolchain hello-app", "create tool repo", "create tool secrets", "create tool pipeline",
+			"create pipeline pipeline-id", "create definition", "create property toolchain-crn", "create property size",
+		}))
+		Expect(result.Toolchain).To(Equal(toolchaintemplate.CreatedResource{ID: "toolchain-1", CRN: "crn:toolchain-1"}))
+		Expect(result.Tools).To(HaveKeyWithValue("pipeline", toolchaintemplate.CreatedResource{ID: "pipeline-id", CRN: "crn:pipeline"}))
+		Expect(parameters["pipeline"]).To(HaveKeyWithValue("notes", "repository repo-id in toolchain-1, built by {{ inputs.builder }}"))
+		Expect(parameters["secrets"]).To(HaveKeyWithValue("instance-crn", "crn:repo"))
+		Expect(parameters["secrets"]).To(HaveKeyWithValue("labels", []interface{}{"crn:toolchain-1"}))
+		Expect(template.Tools[2].Parameters).To(HaveKeyWithValue("instance-crn", "{{tools.repo.crn}}"))
+
+		Expect(pipelineBodies).To(HaveLen(4))
+		Expect(pipelineBodies[0]).To(HaveKeyWithValue("worker", map[string]interface{}{"id": "public"}))
+		Expect(pipelineBodies[0]).To(HaveKeyWithValue("next_build_number", float64(100)))
+		Expect(pipelineBodies[1]).To(HaveKeyWithValue("source", map[string]interface{}{
+			"type": "git",
+			"properties": map[string]interface{}{
+				"url": "https://github.com/org/hello-app", "branch": "main", "path": ".tekton", "tool": map[string]interface{}{"id": "repo-id"},
+			},
+		}))
+		Expect(pipelineBodies[2]).To(HaveKeyWithValue("value", "crn:toolchain-1"))
+		Expect(pipelineBodies[3]).To(HaveKeyWithValue("enum", []interface{}{"s", "m"}))
+		Expect(pipelineBodies[3]).To(HaveKeyWithValue("locked", true))
+	})
+	It(`Deletes what it created when a tool cannot be created`, func() {
+		failType = "pipeline"
+		template, err := toolchaintemplate.Parse([]byte(templateYAML))
+		Expect(err).To(BeNil())
+		result, err := toolchaintemplate.Instantiate(context.Background(), client, pipelineClient, template)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("could not create tool pipeline: invalid parameters"))
+		Expect(result).To(BeNil())
+		Expect(calls).To(Equal([]string{
+			"create toolchain hello-app", "create tool repo", "create tool secrets", "create tool pipeline",
+			"delete /toolchains/toolchain-1/tools/secrets-id", "delete /toolchains/toolchain-1/tools/repo-id", "delete /toolchains/toolchain-1",
+		}))
+	})
+	It(`Deletes the pipeline and what it created when the pipeline cannot be configured`, func() {
+		failProperty = "size"
+		template, err := toolchaintemplate.Parse([]byte(templateYAML))
+		Expect(err).To(BeNil())
+		result, err := toolchaintemplate.Instantiate(context.Background(), client, pipelineClient, template)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("could not configure the pipeline of tool pipeline: invalid property"))
+		Expect(result).To(BeNil())
+		Expect(calls[len(calls)-6:]).To(Equal([]string{
+			"create property size",
+			"delete /tekton_pipelines/pipeline-id", "delete /toolchains/toolchain-1/tools/pipeline-id",
+			"delete /toolchains/toolchain-1/tools/secrets-id", "delete /toolchains/toolchain-1/tools/repo-id", "delete /toolchains/toolchain-1",
+		}))
+	})
+	It(`Returns what it could not delete when the rollback fails`, func() {
+		failProperty, failDelete = "size", "/toolchains/toolchain-1"
+		template, err := toolchaintemplate.Parse([]byte(templateYAML))
+		Expect(err).To(BeNil())
+		result, err := toolchaintemplate.Instantiate(context.Background(), client, pipelineClient, template)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the rollback also failed"))
+		Expect(result).ToNot(BeNil())
+		Expect(result.Toolchain.ID).To(Equal("toolchain-1"))
+		Expect(result.Tools).To(Equal(map[string]toolchaintemplate.CreatedResource{
+			"repo":     {ID: "repo-id", CRN: "crn:repo"},
+			"secrets":  {ID: "secrets-id", CRN: "crn:secrets"},
+			"pipeline": {ID: "pipeline-id", CRN: "crn:pipeline"},
+		}))
+	})
+	It(`Requires a pipeline client for Tekton pipeline tools`, func() {
+		template, err := toolchaintemplate.Parse([]byte(templateYAML))
+		Expect(err).To(BeNil())
+		_, err = toolchaintemplate.Instantiate(context.Background(), client, nil, template)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("pipelineClient cannot be nil"))
+		Expect(calls).To(BeEmpty())
+	})
+})
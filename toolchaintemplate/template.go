@@ -0,0 +1,351 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package toolchaintemplate : Creates toolchains and their tools from declarative templates
+package toolchaintemplate
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// referencePattern matches the placeholders that parameters use to refer to the created toolchain and tools:
+// {{toolchain.id}}, {{toolchain.crn}}, {{tools.<name>.id}} and {{tools.<name>.crn}}. Other {{...}} placeholders, such
+// as those of templates rendered by the tools themselves, are left as they are.
+var referencePattern = regexp.MustCompile(`\{\{\s*((?:toolchain|tools)\.[^{}]*?)\s*\}\}`)
+
+// pipelineToolTypeID is the tool type of delivery pipelines.
+const pipelineToolTypeID = "pipeline"
+
+// Template : A toolchain and the tools to create in it.
+//
+// Tool parameters may refer to the toolchain and to other tools of the template with {{toolchain.id}},
+// {{toolchain.crn}}, {{tools.<name>.id}} and {{tools.<name>.crn}}, which are replaced with the values of the created
+// resources. The same references may be used in the strings of a pipeline section. A tool is created after the tools
+// it refers to and those listed in its depends_on.
+type Template struct {
+	// The name of the toolchain.
+	Name string `yaml:"name"`
+
+	// The ID of the resource group of the toolchain.
+	ResourceGroupID string `yaml:"resource_group_id"`
+
+	// The description of the toolchain.
+	Description string `yaml:"description"`
+
+	// The tools of the toolchain.
+	Tools []*Tool `yaml:"tools"`
+}
+
+// Tool : A tool to create in the toolchain.
+type Tool struct {
+	// The name of the tool, unique in the template and used to refer to it.
+	Name string `yaml:"name"`
+
+	// The type of the tool, such as githubconsolidated or pipeline.
+	ToolTypeID string `yaml:"tool_type_id"`
+
+	// The parameters of the tool.
+	Parameters map[string]interface{} `yaml:"parameters"`
+
+	// The names of tools that must be created first, in addition to those referred to by the parameters.
+	DependsOn []string `yaml:"depends_on"`
+
+	// The configuration of the Tekton pipeline of a pipeline tool. A Tekton pipeline is created for every pipeline tool
+	// with this section or with the `type: tekton` parameter.
+	Pipeline *Pipeline `yaml:"pipeline"`
+}
+
+// Pipeline : The configuration of the Tekton pipeline of a pipeline tool.
+type Pipeline struct {
+	// The ID of the worker that runs the pipeline. The default is the IBM Managed shared workers.
+	Worker string `yaml:"worker"`
+
+	// The build number of the first run.
+	NextBuildNumber *int64 `yaml:"next_build_number"`
+
+	// Whether run events are published to the notification integrations of the toolchain.
+	EnableNotifications *bool `yaml:"enable_notifications"`
+
+	// The repositories of the pipeline's Tekton definitions.
+	Definitions []Definition `yaml:"definitions"`
+
+	// The properties of the pipeline.
+	Properties []Property `yaml:"properties"`
+}
+
+// Definition : A repository of Tekton definitions of a pipeline.
+type Definition struct {
+	// The URL of the repository.
+	URL string `yaml:"url"`
+
+	// The branch to read the definitions from. Exactly one of branch and tag must be set.
+	Branch string `yaml:"branch"`
+
+	// The tag to read the definitions from.
+	Tag string `yaml:"tag"`
+
+	// The path of the definitions in the repository.
+	Path string `yaml:"path"`
+
+	// The ID of the repository tool, usually {{tools.<name>.id}}.
+	Tool string `yaml:"tool"`
+}
+
+// Property : A property of a pipeline.
+type Property struct {
+	// The name of the property.
+	Name string `yaml:"name"`
+
+	// The type of the property, such as text, secure or single_select.
+	Type string `yaml:"type"`
+
+	// The value of the property.
+	Value string `yaml:"value"`
+
+	// The options of a single_select property.
+	Enum []string `yaml:"enum"`
+
+	// Whether the property can be overridden by triggers and runs.
+	Locked bool `yaml:"locked"`
+
+	// The path in the integration of an integration property.
+	Path string `yaml:"path"`
+}
+
+// Load reads a template from a YAML file.
+func Load(path string) (*Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("could not read template %s: %s", path, err.Error()), "read-template-error", common.GetComponentInfo())
+	}
+	return Parse(data)
+}
+
+// Parse reads a template from YAML and validates it.
+func Parse(data []byte) (*Template, error) {
+	template := &Template{}
+	if err := yaml.Unmarshal(data, template); err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("could not parse template: %s", err.Error()), "parse-template-error", common.GetComponentInfo())
+	}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// Validate checks that the toolchain has a name and resource group, that tools have unique names and a type, that
+// pipeline sections belong to pipeline tools and are complete, that references and dependencies name tools of the
+// template, and that there is no dependency cycle.
+func (template *Template) Validate() error {
+	if template.Name == "" {
+		return core.SDKErrorf(nil, "the template must specify a toolchain name", "missing-name", common.GetComponentInfo())
+	}
+	if template.ResourceGroupID == "" {
+		return core.SDKErrorf(nil, "the template must specify a resource group ID", "missing-resource-group", common.GetComponentInfo())
+	}
+	names := map[string]bool{}
+	for i, tool := range template.Tools {
+		if tool == nil || tool.Name == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("tool %d has no name", i+1), "missing-tool-name", common.GetComponentInfo())
+		}
+		if names[tool.Name] {
+			return core.SDKErrorf(nil, fmt.Sprintf("tool %s is defined more than once", tool.Name), "duplicate-tool", common.GetComponentInfo())
+		}
+		names[tool.Name] = true
+		if tool.ToolTypeID == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("tool %s has no tool_type_id", tool.Name), "missing-tool-type", common.GetComponentInfo())
+		}
+		if err := tool.validatePipeline(); err != nil {
+			return err
+		}
+	}
+	for _, tool := range template.Tools {
+		dependencies, err := tool.dependencies()
+		if err != nil {
+			return err
+		}
+		for _, dependency := range dependencies {
+			if !names[dependency] {
+				return core.SDKErrorf(nil, fmt.Sprintf("tool %s depends on unknown tool %s", tool.Name, dependency), "unknown-tool", common.GetComponentInfo())
+			}
+			if dependency == tool.Name {
+				return core.SDKErrorf(nil, fmt.Sprintf("tool %s depends on itself", tool.Name), "dependency-cycle", common.GetComponentInfo())
+			}
+		}
+	}
+	_, err := template.order()
+	return err
+}
+
+// validatePipeline checks the pipeline section of a tool.
+func (tool *Tool) validatePipeline() error {
+	if tool.Pipeline == nil {
+		return nil
+	}
+	if tool.ToolTypeID != pipelineToolTypeID {
+		return core.SDKErrorf(nil, fmt.Sprintf("tool %s has a pipeline section but is not a pipeline tool", tool.Name), "invalid-pipeline", common.GetComponentInfo())
+	}
+	for i, definition := range tool.Pipeline.Definitions {
+		if definition.URL == "" || definition.Path == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("definition %d of tool %s must specify a url and a path", i+1, tool.Name), "invalid-pipeline", common.GetComponentInfo())
+		}
+		if (definition.Branch == "") == (definition.Tag == "") {
+			return core.SDKErrorf(nil, fmt.Sprintf("definition %d of tool %s must specify exactly one of branch and tag", i+1, tool.Name), "invalid-pipeline", common.GetComponentInfo())
+		}
+	}
+	for i, property := range tool.Pipeline.Properties {
+		if property.Name == "" || property.Type == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("property %d of tool %s must specify a name and a type", i+1, tool.Name), "invalid-pipeline", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// tekton returns whether a Tekton pipeline is created for the tool.
+func (tool *Tool) tekton() bool {
+	return tool.ToolTypeID == pipelineToolTypeID && (tool.Pipeline != nil || tool.Parameters["type"] == "tekton")
+}
+
+// order returns the tools in creation order. Tools whose dependencies are created are taken in template order.
+func (template *Template) order() (tools []*Tool, err error) {
+	remaining := map[string]int{}
+	dependents := map[string][]string{}
+	position := map[string]int{}
+	for i, tool := range template.Tools {
+		position[tool.Name] = i
+		dependencies, dependencyErr := tool.dependencies()
+		if dependencyErr != nil {
+			return nil, dependencyErr
+		}
+		remaining[tool.Name] = len(dependencies)
+		for _, dependency := range dependencies {
+			dependents[dependency] = append(dependents[dependency], tool.Name)
+		}
+	}
+	var ready []string
+	for _, tool := range template.Tools {
+		if remaining[tool.Name] == 0 {
+			ready = append(ready, tool.Name)
+		}
+	}
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			return position[ready[i]] < position[ready[j]]
+		})
+		name := ready[0]
+		ready = ready[1:]
+		tools = append(tools, template.Tools[position[name]])
+		for _, dependent := range dependents[name] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if len(tools) != len(template.Tools) {
+		err = core.SDKErrorf(nil, "the template tools contain a dependency cycle", "dependency-cycle", common.GetComponentInfo())
+	}
+	return
+}
+
+// dependencies returns the distinct names of the tools that must be created before this one.
+func (tool *Tool) dependencies() (names []string, err error) {
+	seen := map[string]bool{}
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, name := range tool.DependsOn {
+		add(name)
+	}
+	values := tool.Parameters
+	if tool.Pipeline != nil {
+		values = map[string]interface{}{"parameters": tool.Parameters, "pipeline": tool.Pipeline.strings()}
+	}
+	err = walkStrings(values, func(value string) error {
+		for _, match := range referencePattern.FindAllStringSubmatch(value, -1) {
+			target, _, parseErr := parseReference(match[1])
+			if parseErr != nil {
+				return core.SDKErrorf(nil, fmt.Sprintf("tool %s: %s", tool.Name, parseErr.Error()), "invalid-reference", common.GetComponentInfo())
+			}
+			if target != "" {
+				add(target)
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// strings returns the strings of a pipeline section that may contain references.
+func (pipeline *Pipeline) strings() []interface{} {
+	values := []interface{}{pipeline.Worker}
+	for _, definition := range pipeline.Definitions {
+		values = append(values, definition.URL, definition.Branch, definition.Tag, definition.Path, definition.Tool)
+	}
+	for _, property := range pipeline.Properties {
+		values = append(values, property.Value, property.Path)
+	}
+	return values
+}
+
+// parseReference splits a reference into the referenced tool, empty for the toolchain, and the field.
+func parseReference(reference string) (tool string, field string, err error) {
+	segments := strings.Split(reference, ".")
+	switch {
+	case len(segments) == 2 && segments[0] == "toolchain":
+		tool, field = "", segments[1]
+	case len(segments) == 3 && segments[0] == "tools" && segments[1] != "":
+		tool, field = segments[1], segments[2]
+	default:
+		return "", "", fmt.Errorf("invalid reference {{%s}}, expected toolchain.<field> or tools.<name>.<field>", reference)
+	}
+	if field != "id" && field != "crn" {
+		return "", "", fmt.Errorf("invalid reference {{%s}}, the field must be id or crn", reference)
+	}
+	return
+}
+
+// walkStrings calls visit for every string in a parameter value, including those nested in maps and lists.
+func walkStrings(value interface{}, visit func(value string) error) error {
+	switch typed := value.(type) {
+	case string:
+		return visit(typed)
+	case map[string]interface{}:
+		for _, nested := range typed {
+			if err := walkStrings(nested, visit); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, nested := range typed {
+			if err := walkStrings(nested, visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toolchaintemplate_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/toolchaintemplate"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Template`, func() {
+	Describe(`Load(path)`, func() {
+		It(`Reads and validates a template`, func() {
+			dir, err := os.MkdirTemp("", "toolchaintemplate")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			file := filepath.Join(dir, "toolchain.yaml")
+			Expect(os.WriteFile(file, []byte(templateYAML), 0o644)).To(Succeed())
+
+			template, err := toolchaintemplate.Load(file)
+			Expect(err).To(BeNil())
+			Expect(template.Name).To(Equal("hello-app"))
+			Expect(template.Tools).To(HaveLen(3))
+			Expect(template.Tools[0].DependsOn).To(Equal([]string{"secrets"}))
+			Expect(template.Tools[1].Parameters).To(HaveKeyWithValue("toolchain_issues_enabled", true))
+			Expect(template.Tools[0].Pipeline.Definitions).To(Equal([]toolchaintemplate.Definition{
+				{URL: "https://github.com/org/hello-app", Branch: "main", Path: ".tekton", Tool: "{{tools.repo.id}}"},
+			}))
+			Expect(template.Tools[0].Pipeline.Properties[1].Enum).To(Equal([]string{"s", "m"}))
+		})
+	})
+
+	Describe(`Validate()`, func() {
+		It(`Rejects invalid templates`, func() {
+			for yaml, message := range map[string]string{
+				"resource_group_id: g": "toolchain name",
+				"name: a":              "resource group ID",
+				"name: a\nresource_group_id: g\ntools: [{}]":                                                                                                               "tool 1 has no name",
+				"name: a\nresource_group_id: g\ntools: [{name: r}]":                                                                                                        "tool r has no tool_type_id",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t}, {name: r, tool_type_id: t}]":                                                           "tool r is defined more than once",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t, depends_on: [x]}]":                                                                      "tool r depends on unknown tool x",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t, parameters: {a: '{{tools.r.id}}'}}]":                                                    "tool r depends on itself",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t, parameters: {a: '{{tools.r.url}}'}}]":                                                   "the field must be id or crn",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t, parameters: {a: ['{{tools.repo}}']}}]":                                                  "invalid reference {{tools.repo}}",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t, pipeline: {}}]":                                                                         "not a pipeline tool",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: pipeline, pipeline: {definitions: [{url: u, path: p}]}}]":                                  "exactly one of branch and tag",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: pipeline, pipeline: {properties: [{name: p}]}}]":                                           "must specify a name and a type",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: pipeline, pipeline: {definitions: [{url: u, path: p, tag: v1, tool: '{{tools.s.id}}'}]}}]": "depends on unknown tool s",
+				"name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t, depends_on: [s]}, {name: s, tool_type_id: t, depends_on: [r]}]":                         "dependency cycle",
+			} {
+				_, err := toolchaintemplate.Parse([]byte(yaml))
+				Expect(err).ToNot(BeNil(), yaml)
+				Expect(err.Error()).To(ContainSubstring(message), yaml)
+			}
+		})
+		It(`Leaves other placeholders alone`, func() {
+			template, err := toolchaintemplate.Parse([]byte("name: a\nresource_group_id: g\ntools: [{name: r, tool_type_id: t, parameters: {a: '{{repo}} {{ .Values.x }}'}}]"))
+			Expect(err).To(BeNil())
+			Expect(template.Tools[0].Parameters).To(HaveKeyWithValue("a", "{{repo}} {{ .Values.x }}"))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toolchaintemplate_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestToolchainTemplate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Toolchain Template Suite")
+}
+
+// templateYAML is a template whose pipeline refers to the repository and secrets tools listed after it.
+const templateYAML = `
+name: hello-app
+resource_group_id: group-1
+description: Hello app toolchain
+tools:
+  - name: pipeline
+    tool_type_id: pipeline
+    parameters:
+      type: tekton
+      notes: "repository {{ tools.repo.id }} in {{toolchain.id}}, built by {{ inputs.builder }}"
+    depends_on: [secrets]
+    pipeline:
+      worker: public
+      next_build_number: 100
+      definitions:
+        - url: https://github.com/org/hello-app
+          branch: main
+          path: .tekton
+          tool: "{{tools.repo.id}}"
+      properties:
+        - name: toolchain-crn
+          type: text
+          value: "{{toolchain.crn}}"
+        - name: size
+          type: single_select
+          value: s
+          enum: [s, m]
+          locked: true
+  - name: repo
+    tool_type_id: githubconsolidated
+    parameters:
+      repo_url: https://github.com/org/hello-app
+      toolchain_issues_enabled: true
+  - name: secrets
+    tool_type_id: secretsmanager
+    parameters:
+      instance-id-type: instance-crn
+      instance-crn: "{{tools.repo.crn}}"
+      labels: ["{{toolchain.crn}}"]
+`
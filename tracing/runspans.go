@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys recorded on the spans created by RecordPipelineRun.
+const (
+	AttributeRunStatus    = attribute.Key("cd.pipeline_run.status")
+	AttributeTriggerName  = attribute.Key("cd.trigger.name")
+	AttributeListenerName = attribute.Key("cd.listener_name")
+	AttributeWorkerName   = attribute.Key("cd.worker.name")
+	AttributePodName      = attribute.Key("cd.pod_name")
+	AttributeContainer    = attribute.Key("cd.container_name")
+)
+
+// StepTiming : The time range of a single step.
+type StepTiming struct {
+	// When the step started.
+	Start time.Time
+
+	// When the step ended.
+	End time.Time
+}
+
+// RunSpanOptions : Options for RecordPipelineRun.
+type RunSpanOptions struct {
+	// Timings of individual steps, keyed by Log.ID. The API does not report step timings, so steps without an entry
+	// span the whole run.
+	StepTimings map[string]StepTiming
+}
+
+// RecordPipelineRun records a finished pipeline run as a tree of spans: a root span for the run, a child span for each
+// pod and a grandchild span for each container (step), taken from the `<podName>/<containerName>` name of each Log.
+// The root span is a child of any span in ctx, and its span context is returned so it can be linked to.
+func RecordPipelineRun(ctx context.Context, tracer trace.Tracer, run *cdtektonpipelinev2.PipelineRun, logs []cdtektonpipelinev2.Log, options *RunSpanOptions) (spanContext trace.SpanContext, err error) {
+	if tracer == nil || run == nil {
+		err = core.SDKErrorf(nil, "tracer and run cannot be nil", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if run.Status == nil || run.CreatedAt == nil || !cdtektonpipelinev2.IsTerminalPipelineRunStatus(*run.Status) {
+		err = core.SDKErrorf(nil, "only finished pipeline runs can be recorded", "run-not-finished", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = &RunSpanOptions{}
+	}
+	runStart := time.Time(*run.CreatedAt)
+	runEnd := runStart
+	if run.UpdatedAt != nil {
+		runEnd = time.Time(*run.UpdatedAt)
+	}
+
+	attributes := []attribute.KeyValue{AttributeRunStatus.String(*run.Status)}
+	if run.ID != nil {
+		attributes = append(attributes, AttributePipelineRunID.String(*run.ID))
+	}
+	if run.PipelineID != nil {
+		attributes = append(attributes, AttributePipelineID.String(*run.PipelineID))
+	}
+	triggerName := ""
+	if trigger := cdtektonpipelinev2.AsTrigger(run.Trigger); trigger != nil && trigger.Name != nil {
+		triggerName = *trigger.Name
+		attributes = append(attributes, AttributeTriggerName.String(triggerName))
+	}
+	if run.ListenerName != nil {
+		attributes = append(attributes, AttributeListenerName.String(*run.ListenerName))
+	}
+	if run.Worker != nil && run.Worker.Name != nil {
+		attributes = append(attributes, AttributeWorkerName.String(*run.Worker.Name))
+	}
+
+	spanName := "pipeline run"
+	if triggerName != "" {
+		spanName += " " + triggerName
+	}
+	runCtx, runSpan := tracer.Start(ctx, spanName,
+		trace.WithTimestamp(runStart),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...),
+	)
+	switch *run.Status {
+	case cdtektonpipelinev2.PipelineRunStatusFailedConst, cdtektonpipelinev2.PipelineRunStatusErrorConst:
+		description := *run.Status
+		if run.ErrorMessage != nil && *run.ErrorMessage != "" {
+			description = *run.ErrorMessage
+		}
+		runSpan.SetStatus(codes.Error, description)
+	case cdtektonpipelinev2.PipelineRunStatusSucceededConst:
+		runSpan.SetStatus(codes.Ok, "")
+	}
+
+	type step struct {
+		log    cdtektonpipelinev2.Log
+		name   string
+		timing StepTiming
+	}
+	type pod struct {
+		name   string
+		steps  []step
+		timing StepTiming
+	}
+	var pods []*pod
+	podsByName := map[string]*pod{}
+	for _, log := range logs {
+		if log.Name == nil {
+			continue
+		}
+		podName, containerName := cdtektonpipelinev2.SplitLogName(*log.Name)
+		timing := StepTiming{Start: runStart, End: runEnd}
+		if log.ID != nil {
+			if known, ok := options.StepTimings[*log.ID]; ok {
+				timing = known
+			}
+		}
+		p, ok := podsByName[podName]
+		if !ok {
+			p = &pod{name: podName, timing: timing}
+			podsByName[podName] = p
+			pods = append(pods, p)
+		}
+		if timing.Start.Before(p.timing.Start) {
+			p.timing.Start = timing.Start
+		}
+		if timing.End.After(p.timing.End) {
+			p.timing.End = timing.End
+		}
+		p.steps = append(p.steps, step{log: log, name: containerName, timing: timing})
+	}
+
+	for _, p := range pods {
+		podCtx, podSpan := tracer.Start(runCtx, p.name,
+			trace.WithTimestamp(p.timing.Start),
+			trace.WithAttributes(AttributePodName.String(p.name)),
+		)
+		for _, s := range p.steps {
+			stepAttributes := []attribute.KeyValue{AttributePodName.String(p.name), AttributeContainer.String(s.name)}
+			if s.log.ID != nil {
+				stepAttributes = append(stepAttributes, AttributeLogID.String(*s.log.ID))
+			}
+			_, stepSpan := tracer.Start(podCtx, strings.TrimPrefix(s.name, "step-"),
+				trace.WithTimestamp(s.timing.Start),
+				trace.WithAttributes(stepAttributes...),
+			)
+			stepSpan.End(trace.WithTimestamp(s.timing.End))
+		}
+		podSpan.End(trace.WithTimestamp(p.timing.End))
+	}
+	runSpan.End(trace.WithTimestamp(runEnd))
+	spanContext = runSpan.SpanContext()
+	return
+}
@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/tracing"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var _ = Describe(`RecordPipelineRun`, func() {
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Minute)
+	createdAt := strfmt.DateTime(start)
+	updatedAt := strfmt.DateTime(end)
+	run := &cdtektonpipelinev2.PipelineRun{
+		ID:           core.StringPtr("run-1"),
+		PipelineID:   core.StringPtr("pipeline-1"),
+		Status:       core.StringPtr("failed"),
+		ErrorMessage: core.StringPtr("step-test exited with 1"),
+		Trigger:      &cdtektonpipelinev2.Trigger{Name: core.StringPtr("ci")},
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
+	}
+	logs := []cdtektonpipelinev2.Log{
+		{ID: core.StringPtr("log-1"), Name: core.StringPtr("run-1-build-pod/step-clone")},
+		{ID: core.StringPtr("log-2"), Name: core.StringPtr("run-1-build-pod/step-test")},
+		{ID: core.StringPtr("log-3"), Name: core.StringPtr("run-1-deploy-pod/step-deploy")},
+	}
+
+	It(`Records a span tree`, func() {
+		recorder := tracetest.NewSpanRecorder()
+		tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+		options := &tracing.RunSpanOptions{StepTimings: map[string]tracing.StepTiming{
+			"log-1": {Start: start.Add(time.Minute), End: start.Add(2 * time.Minute)},
+			"log-2": {Start: start.Add(2 * time.Minute), End: start.Add(5 * time.Minute)},
+		}}
+
+		spanContext, err := tracing.RecordPipelineRun(context.Background(), tracer, run, logs, options)
+		Expect(err).To(BeNil())
+		Expect(spanContext.IsValid()).To(BeTrue())
+
+		spans := map[string]sdktrace.ReadOnlySpan{}
+		for _, span := range recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		Expect(spans).To(HaveLen(6))
+
+		root := spans["pipeline run ci"]
+		Expect(root.StartTime()).To(Equal(start))
+		Expect(root.EndTime()).To(Equal(end))
+		Expect(root.Status().Code).To(Equal(codes.Error))
+		Expect(root.Status().Description).To(Equal("step-test exited with 1"))
+		Expect(spanAttributes(root)[tracing.AttributePipelineRunID].AsString()).To(Equal("run-1"))
+
+		buildPod := spans["run-1-build-pod"]
+		Expect(buildPod.Parent().SpanID()).To(Equal(root.SpanContext().SpanID()))
+		Expect(buildPod.StartTime()).To(Equal(start.Add(time.Minute)))
+		Expect(buildPod.EndTime()).To(Equal(start.Add(5 * time.Minute)))
+
+		test := spans["test"]
+		Expect(test.Parent().SpanID()).To(Equal(buildPod.SpanContext().SpanID()))
+		Expect(spanAttributes(test)[tracing.AttributeContainer].AsString()).To(Equal("step-test"))
+
+		deploy := spans["deploy"]
+		Expect(deploy.StartTime()).To(Equal(start))
+		Expect(deploy.EndTime()).To(Equal(end))
+	})
+	It(`Rejects unfinished runs`, func() {
+		tracer := sdktrace.NewTracerProvider().Tracer("test")
+		running := *run
+		running.Status = core.StringPtr("running")
+		_, err := tracing.RecordPipelineRun(context.Background(), tracer, &running, logs, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,287 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracing : OpenTelemetry instrumentation for the Continuous Delivery services
+package tracing
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer used for spans created by this package.
+const InstrumentationName = "github.com/IBM/continuous-delivery-go-sdk/v2/tracing"
+
+// Attribute keys recorded on the spans created by this package.
+const (
+	AttributeOperationID   = attribute.Key("cd.operation_id")
+	AttributeService       = attribute.Key("cd.service")
+	AttributeCorrelationID = attribute.Key("cd.correlation_id")
+	AttributePipelineID    = attribute.Key("cd.pipeline_id")
+	AttributePipelineRunID = attribute.Key("cd.pipeline_run_id")
+	AttributeToolchainID   = attribute.Key("cd.toolchain_id")
+	AttributeToolID        = attribute.Key("cd.tool_id")
+	AttributeTriggerID     = attribute.Key("cd.trigger_id")
+	AttributeDefinitionID  = attribute.Key("cd.definition_id")
+	AttributePropertyName  = attribute.Key("cd.property_name")
+	AttributeLogID         = attribute.Key("cd.log_id")
+)
+
+// headerCorrelationID is the response header holding the ID that the service uses to correlate a request.
+const headerCorrelationID = "X-Correlation-ID"
+
+// TracingOptions : Options for instrumenting a service.
+type TracingOptions struct {
+	// The provider of the tracer used to create spans. The default is the global tracer provider.
+	TracerProvider trace.TracerProvider
+
+	// The propagator used to inject the trace context into outgoing requests. The default is the global propagator.
+	Propagator propagation.TextMapPropagator
+}
+
+// InstrumentCdTektonPipelineV2 makes every operation of the Tekton pipeline service a client span. The trace context
+// is taken from the context passed to the *WithContext methods.
+func InstrumentCdTektonPipelineV2(service *cdtektonpipelinev2.CdTektonPipelineV2, options *TracingOptions) error {
+	if service == nil {
+		return core.SDKErrorf(nil, "service cannot be nil", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	return instrument(service.Service, cdtektonpipelinev2.DefaultServiceName, tektonPipelineRoutes, options)
+}
+
+// InstrumentCdToolchainV2 makes every operation of the toolchain service a client span. The trace context is taken
+// from the context passed to the *WithContext methods.
+func InstrumentCdToolchainV2(service *cdtoolchainv2.CdToolchainV2, options *TracingOptions) error {
+	if service == nil {
+		return core.SDKErrorf(nil, "service cannot be nil", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	return instrument(service.Service, cdtoolchainv2.DefaultServiceName, toolchainRoutes, options)
+}
+
+// instrument wraps the transport of the service's HTTP client. When retries are enabled the transport underneath the
+// retrying client is wrapped instead, so that each attempt becomes a span and EnableRetries/DisableRetries keep
+// working.
+func instrument(service *core.BaseService, serviceName string, routes []route, options *TracingOptions) error {
+	if options == nil {
+		options = &TracingOptions{}
+	}
+	tracerProvider := options.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	propagator := options.Propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+
+	wrap := func(client *http.Client) *http.Client {
+		base := client.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		if _, ok := base.(*transport); ok {
+			return client
+		}
+		wrapped := *client
+		wrapped.Transport = &transport{
+			base:        base,
+			serviceName: serviceName,
+			routes:      routes,
+			tracer:      tracerProvider.Tracer(InstrumentationName, trace.WithInstrumentationVersion(common.Version)),
+			propagator:  propagator,
+		}
+		return &wrapped
+	}
+
+	client := service.GetHTTPClient()
+	if client == nil {
+		return core.SDKErrorf(nil, "the service has no HTTP client", "missing-http-client", common.GetComponentInfo())
+	}
+	if retrying, ok := client.Transport.(*retryablehttp.RoundTripper); ok && retrying.Client != nil && retrying.Client.HTTPClient != nil {
+		retrying.Client.HTTPClient = wrap(retrying.Client.HTTPClient)
+		return nil
+	}
+	service.SetHTTPClient(wrap(client))
+	return nil
+}
+
+// transport : An http.RoundTripper that records each request as a client span.
+type transport struct {
+	base        http.RoundTripper
+	serviceName string
+	routes      []route
+	tracer      trace.Tracer
+	propagator  propagation.TextMapPropagator
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	operationID, attributes := matchRoute(t.routes, request.Method, request.URL.Path)
+	spanName := operationID
+	if spanName == "" {
+		spanName = request.Method
+	}
+	attributes = append(attributes,
+		AttributeService.String(t.serviceName),
+		attribute.String("http.request.method", request.Method),
+		attribute.String("url.full", request.URL.Redacted()),
+		attribute.String("server.address", request.URL.Hostname()),
+	)
+	if operationID != "" {
+		attributes = append(attributes, AttributeOperationID.String(operationID))
+	}
+
+	ctx, span := t.tracer.Start(request.Context(), spanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+	defer span.End()
+
+	request = request.Clone(ctx)
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(request.Header))
+
+	response, err := t.base.RoundTrip(request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return response, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+	if correlationID := response.Header.Get(headerCorrelationID); correlationID != "" {
+		span.SetAttributes(AttributeCorrelationID.String(correlationID))
+	}
+	if response.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(response.StatusCode))
+	}
+	return response, err
+}
+
+// route : An API operation, identified by its method and path template.
+type route struct {
+	method      string
+	segments    []string
+	operationID string
+}
+
+func newRoute(method string, path string, operationID string) route {
+	return route{
+		method:      method,
+		segments:    strings.Split(strings.Trim(path, "/"), "/"),
+		operationID: operationID,
+	}
+}
+
+// pathAttributes maps path template parameters to span attributes.
+var pathAttributes = map[string]attribute.Key{
+	"pipeline_id":     AttributePipelineID,
+	"pipeline_run_id": AttributePipelineRunID,
+	"toolchain_id":    AttributeToolchainID,
+	"tool_id":         AttributeToolID,
+	"trigger_id":      AttributeTriggerID,
+	"definition_id":   AttributeDefinitionID,
+	"property_name":   AttributePropertyName,
+	"log_id":          AttributeLogID,
+}
+
+// matchRoute finds the operation for a request. Templates are matched against the trailing segments of the path, so
+// that any path prefix of the service URL is ignored.
+func matchRoute(routes []route, method string, path string) (operationID string, attributes []attribute.KeyValue) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, r := range routes {
+		if r.method != method || len(r.segments) > len(segments) {
+			continue
+		}
+		tail := segments[len(segments)-len(r.segments):]
+		var matched []attribute.KeyValue
+		ok := true
+		for i, segment := range r.segments {
+			if strings.HasPrefix(segment, "{") {
+				if key, known := pathAttributes[strings.Trim(segment, "{}")]; known {
+					matched = append(matched, key.String(tail[i]))
+				}
+			} else if segment != tail[i] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return r.operationID, matched
+		}
+	}
+	return
+}
+
+// Routes are listed longest first so that the most specific template matches.
+var tektonPipelineRoutes = []route{
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}/properties/{property_name}", "get_tekton_pipeline_trigger_property"),
+	newRoute(http.MethodPut, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}/properties/{property_name}", "replace_tekton_pipeline_trigger_property"),
+	newRoute(http.MethodDelete, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}/properties/{property_name}", "delete_tekton_pipeline_trigger_property"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/pipeline_runs/{pipeline_run_id}/logs/{log_id}", "get_tekton_pipeline_run_log_content"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/pipeline_runs/{pipeline_run_id}/cancel", "cancel_tekton_pipeline_run"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/pipeline_runs/{pipeline_run_id}/rerun", "rerun_tekton_pipeline_run"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/pipeline_runs/{pipeline_run_id}/logs", "get_tekton_pipeline_run_logs"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}/duplicate", "duplicate_tekton_pipeline_trigger"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}/properties", "list_tekton_pipeline_trigger_properties"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}/properties", "create_tekton_pipeline_trigger_properties"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/pipeline_runs/{pipeline_run_id}", "get_tekton_pipeline_run"),
+	newRoute(http.MethodDelete, "/tekton_pipelines/{pipeline_id}/pipeline_runs/{pipeline_run_id}", "delete_tekton_pipeline_run"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/definitions/{definition_id}", "get_tekton_pipeline_definition"),
+	newRoute(http.MethodPut, "/tekton_pipelines/{pipeline_id}/definitions/{definition_id}", "replace_tekton_pipeline_definition"),
+	newRoute(http.MethodDelete, "/tekton_pipelines/{pipeline_id}/definitions/{definition_id}", "delete_tekton_pipeline_definition"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/properties/{property_name}", "get_tekton_pipeline_property"),
+	newRoute(http.MethodPut, "/tekton_pipelines/{pipeline_id}/properties/{property_name}", "replace_tekton_pipeline_property"),
+	newRoute(http.MethodDelete, "/tekton_pipelines/{pipeline_id}/properties/{property_name}", "delete_tekton_pipeline_property"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}", "get_tekton_pipeline_trigger"),
+	newRoute(http.MethodPatch, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}", "update_tekton_pipeline_trigger"),
+	newRoute(http.MethodDelete, "/tekton_pipelines/{pipeline_id}/triggers/{trigger_id}", "delete_tekton_pipeline_trigger"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/pipeline_runs", "list_tekton_pipeline_runs"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/pipeline_runs", "create_tekton_pipeline_run"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/definitions", "list_tekton_pipeline_definitions"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/definitions", "create_tekton_pipeline_definition"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/properties", "list_tekton_pipeline_properties"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/properties", "create_tekton_pipeline_properties"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}/triggers", "list_tekton_pipeline_triggers"),
+	newRoute(http.MethodPost, "/tekton_pipelines/{pipeline_id}/triggers", "create_tekton_pipeline_trigger"),
+	newRoute(http.MethodGet, "/tekton_pipelines/{pipeline_id}", "get_tekton_pipeline"),
+	newRoute(http.MethodPatch, "/tekton_pipelines/{pipeline_id}", "update_tekton_pipeline"),
+	newRoute(http.MethodDelete, "/tekton_pipelines/{pipeline_id}", "delete_tekton_pipeline"),
+	newRoute(http.MethodPost, "/tekton_pipelines", "create_tekton_pipeline"),
+}
+
+// Routes are listed longest first so that the most specific template matches.
+var toolchainRoutes = []route{
+	newRoute(http.MethodGet, "/toolchains/{toolchain_id}/tools/{tool_id}", "get_tool_by_id"),
+	newRoute(http.MethodDelete, "/toolchains/{toolchain_id}/tools/{tool_id}", "delete_tool"),
+	newRoute(http.MethodPatch, "/toolchains/{toolchain_id}/tools/{tool_id}", "update_tool"),
+	newRoute(http.MethodPost, "/toolchains/{toolchain_id}/events", "create_toolchain_event"),
+	newRoute(http.MethodGet, "/toolchains/{toolchain_id}/tools", "list_tools"),
+	newRoute(http.MethodPost, "/toolchains/{toolchain_id}/tools", "create_tool"),
+	newRoute(http.MethodGet, "/toolchains/{toolchain_id}", "get_toolchain_by_id"),
+	newRoute(http.MethodDelete, "/toolchains/{toolchain_id}", "delete_toolchain"),
+	newRoute(http.MethodPatch, "/toolchains/{toolchain_id}", "update_toolchain"),
+	newRoute(http.MethodGet, "/toolchains", "list_toolchains"),
+	newRoute(http.MethodPost, "/toolchains", "create_toolchain"),
+}
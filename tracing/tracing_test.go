@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/tracing"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+var _ = Describe(`Service instrumentation`, func() {
+	var testServer *httptest.Server
+	var recorder *tracetest.SpanRecorder
+	var options *tracing.TracingOptions
+	var traceparent string
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		options = &tracing.TracingOptions{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+			Propagator:     propagation.TraceContext{},
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			traceparent = req.Header.Get("traceparent")
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Correlation-ID", "correlation-1")
+			switch req.URL.EscapedPath() {
+			case "/pipeline/v2/tekton_pipelines/pipeline-1/pipeline_runs/run-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "run-1", "status": "running"}`)
+			case "/pipeline/v2/tekton_pipelines/pipeline-1/pipeline_runs/run-1/cancel":
+				res.WriteHeader(404)
+				fmt.Fprintf(res, "%s", `{"errors": [{"message": "not found"}]}`)
+			case "/api/v2/toolchains/toolchain-1/tools/tool-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "tool-1"}`)
+			default:
+				Fail("unexpected request: " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Records Tekton pipeline operations as client spans`, func() {
+		service, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL + "/pipeline/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(tracing.InstrumentCdTektonPipelineV2(service, options)).To(Succeed())
+
+		ctx, parent := options.TracerProvider.Tracer("test").Start(context.Background(), "parent")
+		_, _, err = service.GetTektonPipelineRunWithContext(ctx, service.NewGetTektonPipelineRunOptions("pipeline-1", "run-1"))
+		Expect(err).To(BeNil())
+		_, _, err = service.CancelTektonPipelineRunWithContext(ctx, service.NewCancelTektonPipelineRunOptions("pipeline-1", "run-1"))
+		Expect(err).ToNot(BeNil())
+		parent.End()
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(3))
+
+		get := spans[0]
+		Expect(get.Name()).To(Equal("get_tekton_pipeline_run"))
+		Expect(get.SpanKind()).To(Equal(trace.SpanKindClient))
+		Expect(get.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		attributes := spanAttributes(get)
+		Expect(attributes[tracing.AttributeOperationID].AsString()).To(Equal("get_tekton_pipeline_run"))
+		Expect(attributes[tracing.AttributePipelineID].AsString()).To(Equal("pipeline-1"))
+		Expect(attributes[tracing.AttributePipelineRunID].AsString()).To(Equal("run-1"))
+		Expect(attributes[tracing.AttributeCorrelationID].AsString()).To(Equal("correlation-1"))
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(200)))
+		Expect(get.Status().Code).To(Equal(codes.Unset))
+
+		cancel := spans[1]
+		Expect(cancel.Name()).To(Equal("cancel_tekton_pipeline_run"))
+		Expect(cancel.Status().Code).To(Equal(codes.Error))
+		Expect(traceparent).To(ContainSubstring(cancel.SpanContext().SpanID().String()))
+	})
+	It(`Records toolchain operations with retries enabled`, func() {
+		service, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL + "/api/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		service.EnableRetries(1, time.Millisecond)
+		Expect(tracing.InstrumentCdToolchainV2(service, options)).To(Succeed())
+		// Instrumenting twice does not nest spans.
+		Expect(tracing.InstrumentCdToolchainV2(service, options)).To(Succeed())
+
+		_, _, err = service.GetToolByID(service.NewGetToolByIDOptions("toolchain-1", "tool-1"))
+		Expect(err).To(BeNil())
+		service.DisableRetries()
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("get_tool_by_id"))
+		attributes := spanAttributes(spans[0])
+		Expect(attributes[tracing.AttributeToolchainID].AsString()).To(Equal("toolchain-1"))
+		Expect(attributes[tracing.AttributeToolID].AsString()).To(Equal("tool-1"))
+	})
+	It(`Rejects a nil service`, func() {
+		Expect(tracing.InstrumentCdTektonPipelineV2(nil, nil)).ToNot(Succeed())
+		Expect(tracing.InstrumentCdToolchainV2(nil, nil)).ToNot(Succeed())
+	})
+})
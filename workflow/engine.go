@@ -0,0 +1,385 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Approval.Decision property.
+const (
+	ApprovalPendingConst  = "pending"
+	ApprovalApprovedConst = "approved"
+	ApprovalRejectedConst = "rejected"
+)
+
+// Approval : The decision of an approver on a stage that requires approval.
+type Approval struct {
+	// The decision: `pending`, `approved` or `rejected`.
+	Decision string
+
+	// Who made the decision.
+	By string
+}
+
+// Engine : Runs workflows and persists their progress.
+type Engine struct {
+	// The client used for stages that do not specify their own.
+	Client *cdtektonpipelinev2.CdTektonPipelineV2
+
+	// The file the workflow state is persisted to after every change. Runs resume from this file. The state is not
+	// persisted when empty.
+	StatePath string
+
+	// Called for stages that require approval. When nil, or when it returns a pending decision, the stage waits until
+	// it is approved with State.Approve or Engine.Approve and the workflow is run again.
+	Approver func(ctx context.Context, stage *Stage, state *State) (Approval, error)
+
+	// Options for waiting on the run of each stage.
+	WaitOptions *cdtektonpipelinev2.WaitOptions
+}
+
+// stageEvent : Sent by the goroutine running a stage.
+type stageEvent struct {
+	stage   string
+	started bool
+	runID   string
+	run     *cdtektonpipelinev2.PipelineRun
+	err     error
+}
+
+// Run runs a workflow until every stage has finished or is waiting for approval. If StatePath holds the state of an
+// earlier run of the workflow, it is resumed: finished stages are not run again and stages whose run was started are
+// waited on rather than started again. A stage is only marked running once the ID of its run is known, so a stage
+// whose run was being created when the earlier run stopped is started again. When a stage fails, or its approval is
+// rejected, no further stages are started unless the failed stage allows failure. The workflow succeeds only if every
+// stage succeeded, was skipped or failed with AllowFailure. The returned state is also returned, with an error, when
+// ctx is done.
+func (engine *Engine) Run(ctx context.Context, workflow *Workflow) (state *State, err error) {
+	err = core.ValidateNotNil(workflow, "workflow cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if err = workflow.Validate(); err != nil {
+		return
+	}
+	order, _ := workflow.order()
+	if state, err = engine.loadState(workflow); err != nil {
+		return
+	}
+	state.Status = StatusRunningConst
+
+	// Every stage sends at most two events, so the goroutines never block once Run has returned.
+	events := make(chan stageEvent, 2*len(workflow.Stages))
+	inFlight := map[string]bool{}
+	for {
+		if err = engine.schedule(ctx, workflow, order, state, inFlight, events); err != nil {
+			break
+		}
+		if err = engine.save(state); err != nil {
+			break
+		}
+		if len(inFlight) == 0 {
+			break
+		}
+		event := <-events
+		stageState := state.Stages[event.stage]
+		now := time.Now().UTC()
+		switch {
+		case event.started:
+			stageState.Status = StatusRunningConst
+			stageState.RunID = event.runID
+			stageState.StartedAt = &now
+			continue
+		case event.err != nil && ctx.Err() != nil:
+			// Leave the stage as it is so that its run is waited on, or created if it has none, when the workflow
+			// resumes.
+		case event.err != nil:
+			stageState.Status = StatusFailedConst
+			stageState.Message = event.err.Error()
+			stageState.FinishedAt = &now
+		default:
+			stageState.Outputs = runOutputs(event.run)
+			stageState.Status = stageState.Outputs["status"]
+			if stageState.Status != StatusSucceededConst {
+				stageState.Status = StatusFailedConst
+				stageState.Message = fmt.Sprintf("pipeline run %s %s", stageState.RunID, stageState.Outputs["status"])
+			}
+			stageState.FinishedAt = &now
+		}
+		delete(inFlight, event.stage)
+		state.UpdatedAt = now
+	}
+	if err == nil && ctx.Err() != nil {
+		err = core.SDKErrorf(ctx.Err(), "", "workflow-cancelled", common.GetComponentInfo())
+	}
+	if err != nil {
+		return
+	}
+
+	state.Status = workflowStatus(workflow, order, state)
+	err = engine.save(state)
+	return
+}
+
+// workflowStatus returns the status of a workflow once no stage is in flight: `failed` if a stage was rejected or
+// failed without AllowFailure, `awaiting_approval` if a stage waits for approval, `running` if a stage has not finished
+// for any other reason, and `succeeded` otherwise.
+func workflowStatus(workflow *Workflow, order []string, state *State) string {
+	awaitingApproval, unfinished := false, false
+	for _, name := range order {
+		switch state.Stages[name].Status {
+		case StatusSucceededConst, StatusSkippedConst:
+		case StatusFailedConst:
+			if !workflow.stage(name).AllowFailure {
+				return StatusFailedConst
+			}
+		case StatusRejectedConst:
+			return StatusFailedConst
+		case StatusAwaitingApprovalConst:
+			awaitingApproval = true
+		default:
+			unfinished = true
+		}
+	}
+	switch {
+	case awaitingApproval:
+		return StatusAwaitingApprovalConst
+	case unfinished:
+		return StatusRunningConst
+	}
+	return StatusSucceededConst
+}
+
+// Approve records the approval of a stage in the state file, so that the stage runs when the workflow is run again.
+func (engine *Engine) Approve(stageName string, approvedBy string) error {
+	return engine.decide(func(state *State) error {
+		return state.Approve(stageName, approvedBy)
+	})
+}
+
+// Reject records the rejection of a stage in the state file.
+func (engine *Engine) Reject(stageName string, rejectedBy string) error {
+	return engine.decide(func(state *State) error {
+		return state.Reject(stageName, rejectedBy)
+	})
+}
+
+func (engine *Engine) decide(decide func(state *State) error) error {
+	if engine.StatePath == "" {
+		return core.SDKErrorf(nil, "the engine has no state file", "missing-state-path", common.GetComponentInfo())
+	}
+	state, err := LoadState(engine.StatePath)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return core.SDKErrorf(nil, "the workflow has not been run", "missing-state", common.GetComponentInfo())
+	}
+	if err = decide(state); err != nil {
+		return err
+	}
+	return state.Save(engine.StatePath)
+}
+
+// schedule starts every stage that is ready to run, asks for the approvals that are due and skips the stages that can
+// no longer run.
+func (engine *Engine) schedule(ctx context.Context, workflow *Workflow, order []string, state *State, inFlight map[string]bool, events chan<- stageEvent) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	shortCircuit := false
+	for _, name := range order {
+		stageState := state.Stages[name]
+		if stageState.Status == StatusRejectedConst || stageState.Status == StatusFailedConst && !workflow.stage(name).AllowFailure {
+			shortCircuit = true
+		}
+	}
+
+	for _, name := range order {
+		stage := workflow.stage(name)
+		stageState := state.Stages[name]
+		if inFlight[name] {
+			continue
+		}
+		if stageState.Status == StatusRunningConst {
+			if stageState.RunID != "" {
+				engine.start(ctx, stage, stageState.RunID, nil, inFlight, events)
+				continue
+			}
+			// The ID of the run was never recorded, so the run is created again.
+			stageState.Status = StatusPendingConst
+			if stageState.ApprovedBy != "" {
+				stageState.Status = StatusApprovedConst
+			}
+		}
+		if stageState.Status != StatusPendingConst && stageState.Status != StatusAwaitingApprovalConst && stageState.Status != StatusApprovedConst {
+			continue
+		}
+		if shortCircuit {
+			stageState.Status = StatusSkippedConst
+			stageState.Message = "an upstream stage failed"
+			continue
+		}
+		ready := true
+		for _, dependency := range stage.DependsOn {
+			switch state.Stages[dependency].Status {
+			case StatusSucceededConst:
+			case StatusFailedConst:
+				if !workflow.stage(dependency).AllowFailure {
+					ready = false
+				}
+			default:
+				ready = false
+			}
+		}
+		if !ready {
+			continue
+		}
+
+		if stage.RequireApproval && stageState.Status != StatusApprovedConst {
+			approval := Approval{Decision: ApprovalPendingConst}
+			if engine.Approver != nil {
+				var err error
+				approval, err = engine.Approver(ctx, stage, state)
+				if err != nil {
+					return core.SDKErrorf(err, "", "approver-error", common.GetComponentInfo())
+				}
+			}
+			switch approval.Decision {
+			case ApprovalApprovedConst:
+				stageState.Status = StatusApprovedConst
+				stageState.ApprovedBy = approval.By
+			case ApprovalRejectedConst:
+				_ = state.Reject(name, approval.By)
+				shortCircuit = true
+				continue
+			default:
+				stageState.Status = StatusAwaitingApprovalConst
+				continue
+			}
+		}
+
+		properties := map[string]interface{}{}
+		for property, value := range stage.Properties {
+			properties[property] = value
+		}
+		for property, reference := range stage.Inputs {
+			upstream, output, _ := splitReference(reference)
+			value, ok := state.Stages[upstream].Outputs[output]
+			if !ok {
+				stageState.Status = StatusFailedConst
+				stageState.Message = fmt.Sprintf("input %s references %s, which stage %s did not output", property, reference, upstream)
+				shortCircuit = !stage.AllowFailure
+				break
+			}
+			properties[property] = value
+		}
+		if stageState.Status == StatusFailedConst {
+			continue
+		}
+		// The stage is marked running once its run has been created and its ID is known.
+		engine.start(ctx, stage, "", properties, inFlight, events)
+	}
+	state.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// start runs a stage in a new goroutine. The run is created unless runID is set, in which case it is only waited on.
+func (engine *Engine) start(ctx context.Context, stage *Stage, runID string, properties map[string]interface{}, inFlight map[string]bool, events chan<- stageEvent) {
+	client := stage.Client
+	if client == nil {
+		client = engine.Client
+	}
+	inFlight[stage.Name] = true
+	go func() {
+		if runID == "" {
+			createOptions := client.NewCreateTektonPipelineRunOptions(stage.PipelineID)
+			createOptions.SetTriggerName(stage.TriggerName)
+			createOptions.SetTriggerProperties(properties)
+			createOptions.SetDescription("workflow stage " + stage.Name)
+			run, _, err := client.CreateTektonPipelineRunWithContext(ctx, createOptions)
+			if err == nil && run.ID == nil {
+				err = core.SDKErrorf(nil, "the created pipeline run has no ID", "missing-run-id", common.GetComponentInfo())
+			}
+			if err != nil {
+				events <- stageEvent{stage: stage.Name, err: err}
+				return
+			}
+			runID = *run.ID
+			events <- stageEvent{stage: stage.Name, started: true, runID: runID}
+		}
+		run, err := client.WaitForTektonPipelineRun(ctx, stage.PipelineID, runID, engine.WaitOptions)
+		events <- stageEvent{stage: stage.Name, run: run, err: err}
+	}()
+}
+
+// loadState returns the persisted state of the workflow, or a new state when there is none.
+func (engine *Engine) loadState(workflow *Workflow) (state *State, err error) {
+	if engine.StatePath != "" {
+		state, err = LoadState(engine.StatePath)
+		if err != nil {
+			return
+		}
+	}
+	if state == nil {
+		state = newState(workflow)
+		return
+	}
+	if state.Workflow != workflow.Name {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the state file belongs to workflow %s", state.Workflow), "state-mismatch", common.GetComponentInfo())
+		return
+	}
+	for _, stage := range workflow.Stages {
+		if _, ok := state.Stages[stage.Name]; !ok {
+			state.Stages[stage.Name] = &StageState{Status: StatusPendingConst}
+		}
+	}
+	return
+}
+
+func (engine *Engine) save(state *State) error {
+	if engine.StatePath == "" {
+		return nil
+	}
+	return state.Save(engine.StatePath)
+}
+
+// runOutputs returns the outputs of a finished run: `run_id`, `run_url`, `status`, `pipeline_id` and
+// `properties.<name>` for every property of the run that is not secure.
+func runOutputs(run *cdtektonpipelinev2.PipelineRun) map[string]string {
+	outputs := map[string]string{
+		"run_id":      core.StringNilMapper(run.ID),
+		"run_url":     core.StringNilMapper(run.RunURL),
+		"status":      core.StringNilMapper(run.Status),
+		"pipeline_id": core.StringNilMapper(run.PipelineID),
+	}
+	for _, property := range run.Properties {
+		if property.Name == nil || property.Value == nil || core.StringNilMapper(property.Type) == cdtektonpipelinev2.PropertyTypeSecureConst {
+			continue
+		}
+		outputs["properties."+*property.Name] = *property.Value
+	}
+	return outputs
+}
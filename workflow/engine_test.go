@@ -0,0 +1,225 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/workflow"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Engine`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var created map[string]map[string]interface{}
+	var statuses map[string]string
+	var statePath string
+	var onCreate func()
+	BeforeEach(func() {
+		created = map[string]map[string]interface{}{}
+		onCreate = nil
+		statuses = map[string]string{}
+		dir, err := os.MkdirTemp("", "workflow")
+		Expect(err).To(BeNil())
+		statePath = filepath.Join(dir, "state.json")
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			segments := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+			Expect(len(segments)).To(BeNumerically(">=", 3))
+			pipelineID := segments[1]
+			mutex.Lock()
+			defer mutex.Unlock()
+			switch {
+			case req.Method == "POST" && len(segments) == 3:
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				created[pipelineID] = body
+				if onCreate != nil {
+					onCreate()
+				}
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "run-%s", "status": "pending"}`, pipelineID)
+			case req.Method == "GET" && len(segments) == 4:
+				status := statuses[pipelineID]
+				if status == "" {
+					status = "succeeded"
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "status": "%s", "pipeline_id": "%s", "run_url": "https://cloud.ibm.com/%s",
+					"properties": [{"name": "image", "type": "text", "value": "icr.io/app:%s"}, {"name": "token", "type": "secure", "value": "hash"}]}`,
+					segments[3], status, pipelineID, segments[3], pipelineID)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(filepath.Dir(statePath))
+	})
+
+	newEngine := func() *workflow.Engine {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return &workflow.Engine{
+			Client:      cdTektonPipelineService,
+			StatePath:   statePath,
+			WaitOptions: &cdtektonpipelinev2.WaitOptions{PollInterval: time.Millisecond},
+		}
+	}
+	promotion := func() *workflow.Workflow {
+		return &workflow.Workflow{
+			Name: "promote",
+			Stages: []*workflow.Stage{
+				{Name: "build", PipelineID: "build", TriggerName: "manual", Properties: map[string]string{"branch": "main"}},
+				{Name: "staging", PipelineID: "staging", TriggerName: "deploy", DependsOn: []string{"build"},
+					Inputs: map[string]string{"image": "build.properties.image", "build_run": "build.run_id"}},
+				{Name: "prod", PipelineID: "prod", TriggerName: "deploy", DependsOn: []string{"staging"}, RequireApproval: true,
+					Inputs: map[string]string{"image": "staging.properties.image"}},
+			},
+		}
+	}
+
+	It(`Passes outputs downstream and pauses for approval until resumed`, func() {
+		engine := newEngine()
+		state, err := engine.Run(context.Background(), promotion())
+		Expect(err).To(BeNil())
+		Expect(state.Status).To(Equal(workflow.StatusAwaitingApprovalConst))
+		Expect(state.Stages["build"].Status).To(Equal(workflow.StatusSucceededConst))
+		Expect(state.Stages["staging"].Outputs).To(HaveKeyWithValue("properties.image", "icr.io/app:staging"))
+		Expect(state.Stages["staging"].Outputs).ToNot(HaveKey("properties.token"))
+		Expect(state.Stages["prod"].Status).To(Equal(workflow.StatusAwaitingApprovalConst))
+		Expect(created["build"]["trigger_properties"]).To(Equal(map[string]interface{}{"branch": "main"}))
+		Expect(created["staging"]["trigger_name"]).To(Equal("deploy"))
+		Expect(created["staging"]["trigger_properties"]).To(Equal(map[string]interface{}{"image": "icr.io/app:build", "build_run": "run-build"}))
+		Expect(created).ToNot(HaveKey("prod"))
+
+		persisted, err := workflow.LoadState(statePath)
+		Expect(err).To(BeNil())
+		Expect(persisted.Status).To(Equal(workflow.StatusAwaitingApprovalConst))
+		Expect(persisted.Stages["build"].RunID).To(Equal("run-build"))
+
+		Expect(engine.Approve("prod", "release-manager")).To(Succeed())
+		delete(created, "build")
+		state, err = engine.Run(context.Background(), promotion())
+		Expect(err).To(BeNil())
+		Expect(state.Status).To(Equal(workflow.StatusSucceededConst))
+		Expect(state.Stages["prod"].ApprovedBy).To(Equal("release-manager"))
+		Expect(created).ToNot(HaveKey("build"))
+		Expect(created["prod"]["trigger_properties"]).To(Equal(map[string]interface{}{"image": "icr.io/app:staging"}))
+	})
+	It(`Short-circuits on failure`, func() {
+		statuses["staging"] = "failed"
+		state, err := newEngine().Run(context.Background(), promotion())
+		Expect(err).To(BeNil())
+		Expect(state.Status).To(Equal(workflow.StatusFailedConst))
+		Expect(state.Stages["staging"].Status).To(Equal(workflow.StatusFailedConst))
+		Expect(state.Stages["staging"].Message).To(Equal("pipeline run run-staging failed"))
+		Expect(state.Stages["prod"].Status).To(Equal(workflow.StatusSkippedConst))
+	})
+	It(`Asks the approver and stops on rejection`, func() {
+		engine := newEngine()
+		engine.StatePath = ""
+		engine.Approver = func(ctx context.Context, stage *workflow.Stage, state *workflow.State) (workflow.Approval, error) {
+			Expect(stage.Name).To(Equal("prod"))
+			return workflow.Approval{Decision: workflow.ApprovalRejectedConst, By: "security"}, nil
+		}
+		state, err := engine.Run(context.Background(), promotion())
+		Expect(err).To(BeNil())
+		Expect(state.Status).To(Equal(workflow.StatusFailedConst))
+		Expect(state.Stages["prod"].Status).To(Equal(workflow.StatusRejectedConst))
+		Expect(state.Stages["prod"].Message).To(Equal("rejected by security"))
+		Expect(created).ToNot(HaveKey("prod"))
+	})
+	It(`Resumes waiting on a run that was already started`, func() {
+		state := &workflow.State{Workflow: "promote", Stages: map[string]*workflow.StageState{
+			"build": {Status: workflow.StatusRunningConst, RunID: "run-42"},
+		}}
+		Expect(state.Save(statePath)).To(Succeed())
+		wf := promotion()
+		wf.Stages = wf.Stages[:1]
+		state, err := newEngine().Run(context.Background(), wf)
+		Expect(err).To(BeNil())
+		Expect(state.Status).To(Equal(workflow.StatusSucceededConst))
+		Expect(state.Stages["build"].Outputs).To(HaveKeyWithValue("run_id", "run-42"))
+		Expect(created).To(BeEmpty())
+	})
+	It(`Creates the run again for a stage whose run ID was not recorded`, func() {
+		state := &workflow.State{Workflow: "promote", Stages: map[string]*workflow.StageState{
+			"build": {Status: workflow.StatusRunningConst},
+		}}
+		Expect(state.Save(statePath)).To(Succeed())
+		wf := promotion()
+		wf.Stages = wf.Stages[:1]
+		state, err := newEngine().Run(context.Background(), wf)
+		Expect(err).To(BeNil())
+		Expect(state.Status).To(Equal(workflow.StatusSucceededConst))
+		Expect(state.Stages["build"].RunID).To(Equal("run-build"))
+		Expect(created).To(HaveKey("build"))
+	})
+	It(`Keeps a stage pending until the ID of its run is known`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		onCreate = cancel
+		_, err := newEngine().Run(ctx, promotion())
+		Expect(err).ToNot(BeNil())
+		persisted, err := workflow.LoadState(statePath)
+		Expect(err).To(BeNil())
+		Expect(persisted.Stages["build"].RunID).To(BeEmpty())
+		Expect(persisted.Stages["build"].Status).To(Equal(workflow.StatusPendingConst))
+
+		delete(created, "build")
+		state, err := newEngine().Run(context.Background(), promotion())
+		Expect(err).To(BeNil())
+		Expect(state.Stages["build"].Status).To(Equal(workflow.StatusSucceededConst))
+		Expect(created).To(HaveKey("build"))
+	})
+	It(`Succeeds when only stages that allow failure failed`, func() {
+		statuses["build"] = "failed"
+		wf := promotion()
+		wf.Stages = wf.Stages[:2]
+		wf.Stages[0].AllowFailure = true
+		state, err := newEngine().Run(context.Background(), wf)
+		Expect(err).To(BeNil())
+		Expect(state.Stages["build"].Status).To(Equal(workflow.StatusFailedConst))
+		Expect(state.Stages["staging"].Status).To(Equal(workflow.StatusSucceededConst))
+		Expect(state.Status).To(Equal(workflow.StatusSucceededConst))
+	})
+	It(`Refuses the state of another workflow`, func() {
+		Expect((&workflow.State{Workflow: "other"}).Save(statePath)).To(Succeed())
+		_, err := newEngine().Run(context.Background(), promotion())
+		Expect(err).ToNot(BeNil())
+	})
+})
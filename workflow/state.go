@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the State.Status and StageState.Status properties.
+const (
+	StatusPendingConst          = "pending"
+	StatusAwaitingApprovalConst = "awaiting_approval"
+	StatusApprovedConst         = "approved"
+	StatusRunningConst          = "running"
+	StatusSucceededConst        = "succeeded"
+	StatusFailedConst           = "failed"
+	StatusRejectedConst         = "rejected"
+	StatusSkippedConst          = "skipped"
+)
+
+// State : The persisted progress of a workflow.
+type State struct {
+	// The name of the workflow.
+	Workflow string `json:"workflow"`
+
+	// The status of the workflow: `running`, `awaiting_approval`, `succeeded` or `failed`.
+	Status string `json:"status"`
+
+	// The state of each stage, by stage name.
+	Stages map[string]*StageState `json:"stages"`
+
+	// When the state last changed.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StageState : The persisted progress of a stage.
+type StageState struct {
+	// The status of the stage.
+	Status string `json:"status"`
+
+	// The ID of the pipeline run started for the stage.
+	RunID string `json:"run_id,omitempty"`
+
+	// The outputs of the stage, available to downstream stages once it has succeeded.
+	Outputs map[string]string `json:"outputs,omitempty"`
+
+	// Who approved or rejected the stage.
+	ApprovedBy string `json:"approved_by,omitempty"`
+
+	// Why the stage failed or was skipped.
+	Message string `json:"message,omitempty"`
+
+	// When the run of the stage was started.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	// When the stage finished.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// newState returns the initial state of a workflow, with every stage pending.
+func newState(workflow *Workflow) *State {
+	state := &State{
+		Workflow: workflow.Name,
+		Status:   StatusRunningConst,
+		Stages:   map[string]*StageState{},
+	}
+	for _, stage := range workflow.Stages {
+		state.Stages[stage.Name] = &StageState{Status: StatusPendingConst}
+	}
+	return state
+}
+
+// LoadState reads the state of a workflow from a file. It returns nil and no error if the file does not exist.
+func LoadState(path string) (state *State, err error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = nil
+		return
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-state-error", common.GetComponentInfo())
+		return
+	}
+	state = new(State)
+	if err = json.Unmarshal(data, state); err != nil {
+		err = core.SDKErrorf(err, "", "unmarshal-state-error", common.GetComponentInfo())
+		state = nil
+	}
+	return
+}
+
+// Save writes the state to a file. The file is replaced atomically so that an interrupted save does not lose the
+// previous state.
+func (state *State) Save(path string) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "marshal-state-error", common.GetComponentInfo())
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-state-error", common.GetComponentInfo())
+	}
+	defer os.Remove(temp.Name())
+	if _, err = temp.Write(data); err == nil {
+		err = temp.Close()
+	} else {
+		temp.Close()
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), path)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-state-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Approve records the approval of a stage that is awaiting approval.
+func (state *State) Approve(stageName string, approvedBy string) error {
+	return state.decide(stageName, approvedBy, StatusApprovedConst)
+}
+
+// Reject records the rejection of a stage that is awaiting approval. Stages that depend on it are skipped.
+func (state *State) Reject(stageName string, rejectedBy string) error {
+	return state.decide(stageName, rejectedBy, StatusRejectedConst)
+}
+
+func (state *State) decide(stageName string, by string, status string) error {
+	stageState, ok := state.Stages[stageName]
+	if !ok {
+		return core.SDKErrorf(nil, "unknown stage "+stageName, "unknown-stage", common.GetComponentInfo())
+	}
+	if stageState.Status != StatusAwaitingApprovalConst && stageState.Status != StatusPendingConst {
+		return core.SDKErrorf(nil, "stage "+stageName+" is "+stageState.Status+" and cannot be approved or rejected", "invalid-stage-status", common.GetComponentInfo())
+	}
+	stageState.Status = status
+	stageState.ApprovedBy = by
+	if status == StatusRejectedConst {
+		now := time.Now().UTC()
+		stageState.FinishedAt = &now
+		stageState.Message = "rejected by " + by
+	}
+	state.UpdatedAt = time.Now().UTC()
+	return nil
+}
@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package workflow : Orchestrates runs across Tekton pipelines as a DAG of stages
+package workflow
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Workflow : A DAG of stages, each of which starts a pipeline run once the stages it depends on have succeeded.
+type Workflow struct {
+	// The name of the workflow.
+	Name string
+
+	// The stages of the workflow. Stage names must be unique.
+	Stages []*Stage
+}
+
+// Stage : A single pipeline run in a workflow.
+type Stage struct {
+	// The name of the stage, unique within the workflow.
+	Name string
+
+	// The ID of the pipeline to run.
+	PipelineID string
+
+	// The name of the trigger to run.
+	TriggerName string
+
+	// The names of the stages that must succeed before this stage starts.
+	DependsOn []string
+
+	// Trigger properties passed unchanged to the run.
+	Properties map[string]string
+
+	// Trigger properties taken from the outputs of upstream stages, as references of the form `<stage>.<output>`, for
+	// example `build.run_id` or `build.properties.image`. The referenced stage must be listed in DependsOn.
+	Inputs map[string]string
+
+	// Wait for approval before starting the run.
+	RequireApproval bool
+
+	// Let downstream stages run even if this stage fails.
+	AllowFailure bool
+
+	// The client used for this stage. The engine's client is used when nil, which allows stages to target pipelines
+	// in different regions.
+	Client *cdtektonpipelinev2.CdTektonPipelineV2
+}
+
+// Validate checks that stage names are unique, that dependencies and input references exist, and that the stages form
+// a DAG.
+func (workflow *Workflow) Validate() error {
+	stages := map[string]*Stage{}
+	for _, stage := range workflow.Stages {
+		if stage == nil || stage.Name == "" {
+			return core.SDKErrorf(nil, "every stage must have a name", "invalid-stage", common.GetComponentInfo())
+		}
+		if strings.Contains(stage.Name, ".") {
+			return core.SDKErrorf(nil, fmt.Sprintf("stage name %s must not contain '.'", stage.Name), "invalid-stage", common.GetComponentInfo())
+		}
+		if _, ok := stages[stage.Name]; ok {
+			return core.SDKErrorf(nil, fmt.Sprintf("duplicate stage %s", stage.Name), "duplicate-stage", common.GetComponentInfo())
+		}
+		if stage.PipelineID == "" || stage.TriggerName == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("stage %s must specify a pipeline and a trigger", stage.Name), "invalid-stage", common.GetComponentInfo())
+		}
+		stages[stage.Name] = stage
+	}
+	for _, stage := range workflow.Stages {
+		for _, dependency := range stage.DependsOn {
+			if _, ok := stages[dependency]; !ok {
+				return core.SDKErrorf(nil, fmt.Sprintf("stage %s depends on unknown stage %s", stage.Name, dependency), "unknown-dependency", common.GetComponentInfo())
+			}
+		}
+		for property, reference := range stage.Inputs {
+			upstream, _, ok := splitReference(reference)
+			if !ok {
+				return core.SDKErrorf(nil, fmt.Sprintf("input %s of stage %s must be of the form <stage>.<output>", property, stage.Name), "invalid-input", common.GetComponentInfo())
+			}
+			if !stage.dependsOn(upstream) {
+				return core.SDKErrorf(nil, fmt.Sprintf("input %s of stage %s references stage %s, which is not a dependency", property, stage.Name, upstream), "invalid-input", common.GetComponentInfo())
+			}
+		}
+	}
+	if _, err := workflow.order(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// order returns the stage names in a topological order, breaking ties by name.
+func (workflow *Workflow) order() (names []string, err error) {
+	remaining := map[string]int{}
+	dependents := map[string][]string{}
+	for _, stage := range workflow.Stages {
+		remaining[stage.Name] = len(stage.DependsOn)
+		for _, dependency := range stage.DependsOn {
+			dependents[dependency] = append(dependents[dependency], stage.Name)
+		}
+	}
+	var ready []string
+	for name, count := range remaining {
+		if count == 0 {
+			ready = append(ready, name)
+		}
+	}
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		name := ready[0]
+		ready = ready[1:]
+		names = append(names, name)
+		for _, dependent := range dependents[name] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if len(names) != len(workflow.Stages) {
+		err = core.SDKErrorf(nil, "the workflow stages contain a dependency cycle", "dependency-cycle", common.GetComponentInfo())
+	}
+	return
+}
+
+// stage returns the stage with the specified name, or nil if there is none.
+func (workflow *Workflow) stage(name string) *Stage {
+	for _, stage := range workflow.Stages {
+		if stage.Name == name {
+			return stage
+		}
+	}
+	return nil
+}
+
+func (stage *Stage) dependsOn(name string) bool {
+	for _, dependency := range stage.DependsOn {
+		if dependency == name {
+			return true
+		}
+	}
+	return false
+}
+
+// splitReference splits an input reference into the stage name and the output name.
+func splitReference(reference string) (stage string, output string, ok bool) {
+	stage, output, ok = strings.Cut(reference, ".")
+	ok = ok && stage != "" && output != ""
+	return
+}
@@ -0,0 +1,58 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow_test
+
+import (
+	"github.com/IBM/continuous-delivery-go-sdk/v2/workflow"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Workflow.Validate()`, func() {
+	stage := func(name string, dependsOn ...string) *workflow.Stage {
+		return &workflow.Stage{Name: name, PipelineID: "pipeline-" + name, TriggerName: "manual", DependsOn: dependsOn}
+	}
+
+	It(`Accepts a DAG`, func() {
+		wf := &workflow.Workflow{Name: "promote", Stages: []*workflow.Stage{stage("build"), stage("staging", "build"), stage("prod", "staging")}}
+		wf.Stages[2].Inputs = map[string]string{"image": "staging.properties.image"}
+		Expect(wf.Validate()).To(Succeed())
+	})
+	It(`Rejects duplicate stages`, func() {
+		wf := &workflow.Workflow{Stages: []*workflow.Stage{stage("build"), stage("build")}}
+		Expect(wf.Validate()).ToNot(Succeed())
+	})
+	It(`Rejects unknown dependencies`, func() {
+		wf := &workflow.Workflow{Stages: []*workflow.Stage{stage("staging", "build")}}
+		Expect(wf.Validate().Error()).To(ContainSubstring("unknown stage build"))
+	})
+	It(`Rejects cycles`, func() {
+		wf := &workflow.Workflow{Stages: []*workflow.Stage{stage("a", "b"), stage("b", "a")}}
+		Expect(wf.Validate().Error()).To(ContainSubstring("cycle"))
+	})
+	It(`Rejects inputs from stages that are not dependencies`, func() {
+		wf := &workflow.Workflow{Stages: []*workflow.Stage{stage("build"), stage("prod")}}
+		wf.Stages[1].Inputs = map[string]string{"image": "build.run_id"}
+		Expect(wf.Validate().Error()).To(ContainSubstring("not a dependency"))
+		wf.Stages[1].Inputs = map[string]string{"image": "build"}
+		Expect(wf.Validate().Error()).To(ContainSubstring("<stage>.<output>"))
+	})
+	It(`Rejects stages without a pipeline or trigger`, func() {
+		wf := &workflow.Workflow{Stages: []*workflow.Stage{{Name: "build"}}}
+		Expect(wf.Validate()).ToNot(Succeed())
+	})
+})